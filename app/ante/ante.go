@@ -62,11 +62,30 @@ func NewAnteHandler(
 						NewEthSigVerificationDecorator(evmKeeper),
 						NewEthAccountVerificationDecorator(ak, bankKeeper, evmKeeper),
 						NewEthNonceVerificationDecorator(ak),
-						NewEthGasConsumeDecorator(ak, bankKeeper, evmKeeper),
+						NewEthGasConsumeDecorator(ak, bankKeeper, feeGrantKeeper, evmKeeper),
 						NewCanTransferDecorator(evmKeeper),
 						NewEthIncrementSenderSequenceDecorator(ak), // innermost AnteDecorator.
 					)
 
+				case "/ethermint.types.v1.ExtensionOptionsWeb3Tx":
+					// handle as normal Cosmos SDK tx, except signature is checked for EIP712 representation
+
+					anteHandler = sdk.ChainAnteDecorators(
+						authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+						authante.NewMempoolFeeDecorator(),
+						authante.NewValidateBasicDecorator(),
+						authante.NewTxTimeoutHeightDecorator(),
+						authante.NewValidateMemoDecorator(ak),
+						ibcante.NewAnteDecorator(channelKeeper),
+						authante.NewConsumeGasForTxSizeDecorator(ak),
+						authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+						authante.NewValidateSigCountDecorator(ak),
+						authante.NewDeductFeeDecorator(ak, bankKeeper, feeGrantKeeper),
+						authante.NewSigGasConsumeDecorator(ak, DefaultSigVerificationGasConsumer),
+						NewEip712SigVerificationDecorator(ak),
+						authante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
+					)
+
 				default:
 					return ctx, stacktrace.Propagate(
 						sdkerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, typeURL),
@@ -4,7 +4,9 @@ import (
 	"math/big"
 	"strings"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/tharsis/ethermint/tests"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
@@ -145,3 +147,104 @@ func (suite AnteTestSuite) TestAnteHandler() {
 		})
 	}
 }
+
+func (suite AnteTestSuite) TestAnteHandlerMultipleMsgs() {
+	addr1, privKey1 := tests.NewAddrKey()
+	addr2, privKey2 := tests.NewAddrKey()
+	addr3, privKey3 := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	newTx := func(from common.Address, nonce uint64, to *common.Address) *evmtypes.MsgEthereumTx {
+		var msg *evmtypes.MsgEthereumTx
+		if to == nil {
+			msg = evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), nonce, big.NewInt(10), 100000, big.NewInt(1), nil, nil)
+		} else {
+			msg = evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, to, big.NewInt(10), 100000, big.NewInt(1), nil, nil)
+		}
+		msg.From = from.Hex()
+		return msg
+	}
+
+	testCases := []struct {
+		name    string
+		msgs    func() []*evmtypes.MsgEthereumTx
+		privs   []cryptotypes.PrivKey
+		expSeqs []uint64
+		expPass bool
+	}{
+		{
+			"success - multiple senders",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newTx(addr1, 0, &to), newTx(addr2, 0, &to)}
+			},
+			[]cryptotypes.PrivKey{privKey1, privKey2},
+			[]uint64{1, 1},
+			true,
+		},
+		{
+			"success - consecutive nonces of the same sender",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newTx(addr1, 0, &to), newTx(addr1, 1, &to), newTx(addr1, 2, nil)}
+			},
+			[]cryptotypes.PrivKey{privKey1, privKey1, privKey1},
+			// the nonce of the contract creation is incremented on execution
+			[]uint64{2, 0},
+			true,
+		},
+		{
+			"fail - nonce gap of the same sender",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newTx(addr1, 0, &to), newTx(addr1, 2, &to)}
+			},
+			[]cryptotypes.PrivKey{privKey1, privKey1},
+			nil,
+			false,
+		},
+		{
+			"fail - call after a contract creation of the same sender",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newTx(addr1, 0, nil), newTx(addr1, 1, &to)}
+			},
+			[]cryptotypes.PrivKey{privKey1, privKey1},
+			nil,
+			false,
+		},
+		{
+			"fail - sender account not found",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newTx(addr1, 0, &to), newTx(addr3, 0, &to)}
+			},
+			[]cryptotypes.PrivKey{privKey1, privKey3},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.app.EvmKeeper.WithContext(ctx)
+
+			for _, addr := range []common.Address{addr1, addr2} {
+				suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr.Bytes()))
+				suite.app.EvmKeeper.AddBalance(addr, big.NewInt(10000000000))
+			}
+
+			newCtx, err := suite.anteHandler(ctx, suite.CreateTestMultiMsgTx(tc.msgs(), tc.privs), false)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				// the gas consumed by the ante handler is not charged
+				suite.Require().Zero(newCtx.GasMeter().GasConsumed())
+				suite.Require().Equal(tc.expSeqs[0], suite.app.EvmKeeper.GetNonce(addr1))
+				suite.Require().Equal(tc.expSeqs[1], suite.app.EvmKeeper.GetNonce(addr2))
+				// the messages are executed atomically
+				suite.Require().Equal(uint64(len(tc.privs)), suite.app.EvmKeeper.GetTxMsgsTransient())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+}
@@ -0,0 +1,187 @@
+package ante
+
+import (
+	"github.com/palantir/stacktrace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/ethereum/eip712"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// Eip712SigVerificationDecorator verifies the EIP-712 signature of a Cosmos transaction signed by
+// an Ethereum wallet, as defined by the ExtensionOptionsWeb3Tx extension option of the transaction.
+type Eip712SigVerificationDecorator struct {
+	ak evmtypes.AccountKeeper
+}
+
+// NewEip712SigVerificationDecorator creates a new Eip712SigVerificationDecorator
+func NewEip712SigVerificationDecorator(ak evmtypes.AccountKeeper) Eip712SigVerificationDecorator {
+	return Eip712SigVerificationDecorator{
+		ak: ak,
+	}
+}
+
+// AnteHandle verifies that the transaction has a single signer with an eth_secp256k1 public key,
+// and that its signature is the EIP-712 signature of the typed data of the amino JSON sign bytes
+// of the transaction. The typed data chain ID of the extension option must match the EIP-155
+// chain ID of the chain. Ethereum transactions are rejected, as they are signed on their own.
+func (svd Eip712SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return ctx, stacktrace.Propagate(
+				sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%T cannot be signed with EIP-712", msg),
+				"rejecting EIP-712 transaction",
+			)
+		}
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid transaction type %T", tx),
+			"failed to cast transaction",
+		)
+	}
+
+	extOpt, err := web3ExtensionOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	chainID, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return ctx, stacktrace.Propagate(err, "failed to parse chain ID %s", ctx.ChainID())
+	}
+
+	if !chainID.IsUint64() || chainID.Uint64() != extOpt.TypedDataChainID {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrInvalidChainID, "typed data chain ID %d doesn't match chain ID %s", extOpt.TypedDataChainID, chainID),
+			"invalid EIP-712 domain",
+		)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) != 1 || len(signers) != 1 {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "EIP-712 transactions must have a single signer, got %d", len(signers)),
+			"invalid EIP-712 transaction",
+		)
+	}
+
+	// fee delegation is not supported, the signer pays the fees
+	if len(extOpt.FeePayerSig) > 0 || (extOpt.FeePayer != "" && extOpt.FeePayer != signers[0].String()) {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "EIP-712 fee payer must be the signer"),
+			"invalid EIP-712 fee payer",
+		)
+	}
+
+	acc, err := authante.GetSignerAcc(ctx, svd.ak, signers[0])
+	if err != nil {
+		return ctx, err
+	}
+
+	// the public key is set by the SetPubKeyDecorator
+	pubKey, ok := acc.GetPubKey().(*ethsecp256k1.PubKey)
+	if !ok {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "EIP-712 signer must have an %s public key, got %T", ethsecp256k1.KeyType, acc.GetPubKey()),
+			"invalid EIP-712 signer",
+		)
+	}
+
+	sig := sigs[0]
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence),
+			"invalid EIP-712 signature",
+		)
+	}
+
+	// skip the signature verification on simulations, as the signature may be empty
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "EIP-712 signatures must use the %s sign mode", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON),
+			"invalid EIP-712 signature",
+		)
+	}
+
+	// account number is zero for the genesis transactions
+	accNum := acc.GetAccountNumber()
+	if ctx.BlockHeight() == 0 {
+		accNum = 0
+	}
+
+	// the legacy amino JSON sign mode handler doesn't support the extension options, so the sign
+	// document is built from the transaction fields
+	signDocBytes := legacytx.StdSignBytes(
+		ctx.ChainID(), accNum, acc.GetSequence(), sigTx.GetTimeoutHeight(),
+		legacytx.StdFee{Amount: sigTx.GetFee(), Gas: sigTx.GetGas()},
+		sigTx.GetMsgs(), sigTx.GetMemo(),
+	)
+
+	typedData, err := eip712.WrapTxToTypedData(extOpt.TypedDataChainID, signDocBytes)
+	if err != nil {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error()),
+			"failed to encode the EIP-712 typed data of the transaction",
+		)
+	}
+
+	signBytes, err := eip712.TypedDataSignBytes(typedData)
+	if err != nil {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error()),
+			"failed to encode the EIP-712 typed data of the transaction",
+		)
+	}
+
+	if !pubKey.VerifySignature(signBytes, data.Signature) {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "EIP-712 signature verification failed"),
+			"invalid EIP-712 signature for account %s", signers[0],
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// web3ExtensionOption returns the ExtensionOptionsWeb3Tx extension option of the transaction
+func web3ExtensionOption(tx sdk.Tx) (*ethermint.ExtensionOptionsWeb3Tx, error) {
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok || len(txWithExtensions.GetExtensionOptions()) != 1 {
+		return nil, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, "EIP-712 transactions must have a single extension option"),
+			"invalid EIP-712 transaction",
+		)
+	}
+
+	// the extension options are not unpacked by the transaction decoder
+	var extOpt ethermint.ExtensionOptionsWeb3Tx
+	if err := extOpt.Unmarshal(txWithExtensions.GetExtensionOptions()[0].Value); err != nil {
+		return nil, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, err.Error()),
+			"failed to unmarshal the EIP-712 extension option",
+		)
+	}
+
+	return &extOpt, nil
+}
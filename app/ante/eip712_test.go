@@ -0,0 +1,185 @@
+package ante_test
+
+import (
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/tharsis/ethermint/ethereum/eip712"
+	"github.com/tharsis/ethermint/tests"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// CreateEip712Tx is a helper function to create a Cosmos tx signed with EIP-712 by the given key.
+func (suite *AnteTestSuite) CreateEip712Tx(
+	msgs []sdk.Msg, priv cryptotypes.PrivKey, accNum, sequence uint64, extOpt *ethermint.ExtensionOptionsWeb3Tx, signMode signing.SignMode,
+) authsigning.Tx {
+	option, err := codectypes.NewAnyWithValue(extOpt)
+	suite.Require().NoError(err)
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	suite.Require().True(ok)
+
+	builder.SetExtensionOptions(option)
+	suite.Require().NoError(builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(200000))))
+	builder.SetGasLimit(200000)
+
+	sigV2 := signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: sequence,
+	}
+	suite.Require().NoError(txBuilder.SetSignatures(sigV2))
+
+	tx := txBuilder.GetTx()
+	signDocBytes := legacytx.StdSignBytes(
+		suite.ctx.ChainID(), accNum, sequence, tx.GetTimeoutHeight(),
+		legacytx.StdFee{Amount: tx.GetFee(), Gas: tx.GetGas()}, tx.GetMsgs(), tx.GetMemo(),
+	)
+
+	typedData, err := eip712.WrapTxToTypedData(extOpt.TypedDataChainID, signDocBytes)
+	suite.Require().NoError(err)
+
+	signBytes, err := eip712.TypedDataSignBytes(typedData)
+	suite.Require().NoError(err)
+
+	sigV2.Data.(*signing.SingleSignatureData).Signature, err = priv.Sign(signBytes)
+	suite.Require().NoError(err)
+	suite.Require().NoError(txBuilder.SetSignatures(sigV2))
+
+	return txBuilder.GetTx()
+}
+
+func (suite AnteTestSuite) TestEip712AnteHandler() {
+	addr, privKey := tests.NewAddrKey()
+	_, otherKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.app.EvmKeeper.AddBalance(addr, big.NewInt(10000000000))
+
+	chainID := suite.app.EvmKeeper.ChainID().Uint64()
+	accNum := acc.GetAccountNumber()
+	send := banktypes.NewMsgSend(addr.Bytes(), to.Bytes(), sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(1))))
+
+	testCases := []struct {
+		name    string
+		txFn    func() sdk.Tx
+		expPass bool
+	}{
+		{
+			"success",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, privKey, accNum, 0,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				)
+			},
+			true,
+		},
+		{
+			"success - signer as fee payer",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, privKey, accNum, 0,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID, FeePayer: sdk.AccAddress(addr.Bytes()).String()},
+					signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				)
+			},
+			true,
+		},
+		{
+			"fail - invalid typed data chain ID",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, privKey, accNum, 0,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: 1}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				)
+			},
+			false,
+		},
+		{
+			"fail - invalid signer key",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, otherKey, accNum, 0,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				)
+			},
+			false,
+		},
+		{
+			"fail - invalid sequence",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, privKey, accNum, 1,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				)
+			},
+			false,
+		},
+		{
+			"fail - invalid sign mode",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, privKey, accNum, 0,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID}, signing.SignMode_SIGN_MODE_DIRECT,
+				)
+			},
+			false,
+		},
+		{
+			"fail - fee delegation",
+			func() sdk.Tx {
+				return suite.CreateEip712Tx(
+					[]sdk.Msg{send}, privKey, accNum, 0,
+					&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID, FeePayer: sdk.AccAddress(to.Bytes()).String()},
+					signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				)
+			},
+			false,
+		},
+		{
+			"fail - ethereum tx",
+			func() sdk.Tx {
+				msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, big.NewInt(10), 100000, big.NewInt(1), nil, nil)
+				msg.From = addr.Hex()
+
+				// the amino JSON sign bytes can't be computed for Ethereum txs, so the tx is left unsigned
+				option, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionsWeb3Tx{TypedDataChainID: chainID})
+				suite.Require().NoError(err)
+
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+				suite.Require().NoError(txBuilder.SetMsgs(msg))
+				return txBuilder.GetTx()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := suite.anteHandler(ctx, tc.txFn(), false)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(ctx, addr.Bytes()).GetSequence())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -28,8 +28,13 @@ type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	WithContext(ctx sdk.Context)
 	ResetRefundTransient(ctx sdk.Context)
+	ResetGasUsedTransient(ctx sdk.Context)
+	SetTxMsgsTransient(ctx sdk.Context, msgs uint64)
+	SetSimulationTransient(ctx sdk.Context, simulate bool)
 	NewEVM(msg core.Message, config *params.ChainConfig, params evmtypes.Params, coinbase common.Address, tracer vm.Tracer) *vm.EVM
 	GetCodeHash(addr common.Address) common.Hash
+	SetFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer sdk.AccAddress)
+	GetBaseFee(ctx sdk.Context) *big.Int
 }
 
 // EthSigVerificationDecorator validates an ethereum signatures
@@ -50,9 +55,9 @@ func NewEthSigVerificationDecorator(ek EVMKeeper) EthSigVerificationDecorator {
 // Failure in RecheckTx will prevent tx to be included into block, especially when CheckTx succeed, in which case user
 // won't see the error message.
 func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if tx == nil || len(tx.GetMsgs()) != 1 {
+	if tx == nil || len(tx.GetMsgs()) == 0 {
 		return ctx, stacktrace.Propagate(
-			sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only 1 ethereum msg supported per tx"),
+			sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least 1 ethereum msg required per tx"),
 			"",
 		)
 	}
@@ -65,28 +70,29 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 	blockNum := big.NewInt(ctx.BlockHeight())
 	signer := ethtypes.MakeSigner(ethCfg, blockNum)
 
-	msg := tx.GetMsgs()[0]
-	msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
-	if !ok {
-		return ctx, stacktrace.Propagate(
-			sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid transaction type %T, expected %T", tx, &evmtypes.MsgEthereumTx{}),
-			"failed to cast transaction",
-		)
-	}
+	for i, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, stacktrace.Propagate(
+				sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid transaction type %T, expected %T", tx, &evmtypes.MsgEthereumTx{}),
+				"failed to cast transaction %d", i,
+			)
+		}
 
-	sender, err := signer.Sender(msgEthTx.AsTransaction())
-	if err != nil {
-		return ctx, stacktrace.Propagate(
-			sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, err.Error()),
-			"couldn't retrieve sender address ('%s') from the ethereum transaction",
-			msgEthTx.From,
-		)
-	}
+		sender, err := signer.Sender(msgEthTx.AsTransaction())
+		if err != nil {
+			return ctx, stacktrace.Propagate(
+				sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, err.Error()),
+				"couldn't retrieve sender address ('%s') from the ethereum transaction %d",
+				msgEthTx.From, i,
+			)
+		}
 
-	// set up the sender to the transaction field if not already
-	msgEthTx.From = sender.Hex()
+		// set up the sender to the transaction field if not already
+		msgEthTx.From = sender.Hex()
+	}
 
-	return next(ctx, msgEthTx, simulate)
+	return next(ctx, tx, simulate)
 }
 
 // EthAccountVerificationDecorator validates an account balance checks
@@ -111,6 +117,8 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, bankKeeper ev
 // - any of the msgs is not a MsgEthereumTx
 // - from address is empty
 // - account balance is lower than the transaction cost
+//
+// The fees of the transactions paid by a fee granter are not checked against the sender balance.
 func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	if !ctx.IsCheckTx() {
 		return next(ctx, tx, simulate)
@@ -119,6 +127,11 @@ func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 	avd.evmKeeper.WithContext(ctx)
 	evmDenom := avd.evmKeeper.GetParams(ctx).EvmDenom
 
+	feeGranter, err := ethFeeGranter(tx)
+	if err != nil {
+		return ctx, err
+	}
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -156,6 +169,11 @@ func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 			avd.ak.SetAccount(ctx, acc)
 		}
 
+		// the transferred value is checked by the CanTransferDecorator
+		if feeGranter != nil {
+			continue
+		}
+
 		if err := evmkeeper.CheckSenderBalance(ctx, avd.bankKeeper, from, txData, evmDenom); err != nil {
 			return ctx, stacktrace.Propagate(err, "failed to check sender balance")
 		}
@@ -180,13 +198,18 @@ func NewEthNonceVerificationDecorator(ak evmtypes.AccountKeeper) EthNonceVerific
 }
 
 // AnteHandle validates that the transaction nonces are valid and equivalent to the sender account’s
-// current nonce.
+// current nonce. The messages of the same sender must have consecutive nonces, and its contract
+// creations must follow its other messages, as their nonce is only incremented on execution.
 func (nvd EthNonceVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// no need to check the nonce on ReCheckTx
 	if ctx.IsReCheckTx() {
 		return next(ctx, tx, simulate)
 	}
 
+	// next expected nonce and contract creation flag of the senders of the previous messages
+	nonces := make(map[string]uint64)
+	creations := make(map[string]bool)
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -196,17 +219,20 @@ func (nvd EthNonceVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 			)
 		}
 
-		// sender address should be in the tx cache from the previous AnteHandle call
-		seq, err := nvd.ak.GetSequence(ctx, msgEthTx.GetFrom())
-		if err != nil {
-			return ctx, stacktrace.Propagate(err, "sequence not found for address %s", msgEthTx.From)
-		}
-
 		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
 		if err != nil {
 			return ctx, stacktrace.Propagate(err, "failed to unpack tx data")
 		}
 
+		seq, found := nonces[msgEthTx.From]
+		if !found {
+			// sender address should be in the tx cache from the previous AnteHandle call
+			seq, err = nvd.ak.GetSequence(ctx, msgEthTx.GetFrom())
+			if err != nil {
+				return ctx, stacktrace.Propagate(err, "sequence not found for address %s", msgEthTx.From)
+			}
+		}
+
 		// if multiple transactions are submitted in succession with increasing nonces,
 		// all will be rejected except the first, since the first needs to be included in a block
 		// before the sequence increments
@@ -219,6 +245,19 @@ func (nvd EthNonceVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 				"",
 			)
 		}
+
+		if creations[msgEthTx.From] && txData.GetTo() != nil {
+			return ctx, stacktrace.Propagate(
+				sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidSequence,
+					"message %d of %s must be a contract creation, as it follows one", i, msgEthTx.From,
+				),
+				"",
+			)
+		}
+
+		nonces[msgEthTx.From] = seq + 1
+		creations[msgEthTx.From] = txData.GetTo() == nil
 	}
 
 	return next(ctx, tx, simulate)
@@ -227,17 +266,21 @@ func (nvd EthNonceVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
 // gas consumption.
 type EthGasConsumeDecorator struct {
-	ak         evmtypes.AccountKeeper
-	bankKeeper evmtypes.BankKeeper
-	evmKeeper  EVMKeeper
+	ak             evmtypes.AccountKeeper
+	bankKeeper     evmtypes.BankKeeper
+	feegrantKeeper authante.FeegrantKeeper
+	evmKeeper      EVMKeeper
 }
 
 // NewEthGasConsumeDecorator creates a new EthGasConsumeDecorator
-func NewEthGasConsumeDecorator(ak evmtypes.AccountKeeper, bankKeeper evmtypes.BankKeeper, ek EVMKeeper) EthGasConsumeDecorator {
+func NewEthGasConsumeDecorator(
+	ak evmtypes.AccountKeeper, bankKeeper evmtypes.BankKeeper, feegrantKeeper authante.FeegrantKeeper, ek EVMKeeper,
+) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
-		ak:         ak,
-		bankKeeper: bankKeeper,
-		evmKeeper:  ek,
+		ak:             ak,
+		bankKeeper:     bankKeeper,
+		feegrantKeeper: feegrantKeeper,
+		evmKeeper:      ek,
 	}
 }
 
@@ -253,11 +296,28 @@ func NewEthGasConsumeDecorator(ak evmtypes.AccountKeeper, bankKeeper evmtypes.Ba
 // - the message is not a MsgEthereumTx
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
-// - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price)
+// - transaction's gas fee cap is lower than the EIP-1559 base fee of the block
+// - user doesn't have enough balance to deduct the transaction fees (gas_limit * effective_gas_price)
+// - the fee granter, if any, didn't grant an allowance covering the fees to the sender
 // - transaction or block gas meter runs out of gas
+//
+// When the transaction has a fee granter, the fees are deducted from the granter balance through
+// its fee allowance to the sender, and the leftover gas is refunded to the granter.
+//
+// When the base fee is enforced by the fee market, the fees are charged at the effective gas price
+// of the transaction, i.e. min(gas_fee_cap, base_fee + gas_tip_cap). The base fee part of the fees
+// paid for the gas used is handled by the EVM keeper after the transaction execution.
+//
+// The transactions rejected on their intrinsic gas are counted by a telemetry metric, except on
+// simulations and rechecks.
 func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	// reset the refund gas value in the keeper for the current transaction
+	// reset the refund gas value and the gas used by the ethereum transactions in the keeper for the
+	// current transaction, and set its number of messages, which are executed atomically if several,
+	// and whether it is simulated, as the simulations don't emit the transaction metrics
 	egcd.evmKeeper.ResetRefundTransient(ctx)
+	egcd.evmKeeper.ResetGasUsedTransient(ctx)
+	egcd.evmKeeper.SetTxMsgsTransient(ctx, uint64(len(tx.GetMsgs())))
+	egcd.evmKeeper.SetSimulationTransient(ctx, simulate)
 
 	params := egcd.evmKeeper.GetParams(ctx)
 
@@ -267,6 +327,19 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	homestead := ethCfg.IsHomestead(blockHeight)
 	istanbul := ethCfg.IsIstanbul(blockHeight)
 	evmDenom := params.EvmDenom
+	baseFee := egcd.evmKeeper.GetBaseFee(ctx)
+
+	feeGranter, err := ethFeeGranter(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if feeGranter != nil && egcd.feegrantKeeper == nil {
+		return ctx, stacktrace.Propagate(
+			sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled"),
+			"failed to use the allowance of the fee granter %s", feeGranter,
+		)
+	}
 
 	var events sdk.Events
 
@@ -284,18 +357,47 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, stacktrace.Propagate(err, "failed to unpack tx data")
 		}
 
-		fees, err := evmkeeper.DeductTxCostsFromUserBalance(
+		// reject the transactions that can't pay the base fee, as on Ethereum
+		if gasFeeCap := txData.GetGasFeeCap(); baseFee != nil && (gasFeeCap == nil || gasFeeCap.Cmp(baseFee) < 0) {
+			return ctx, stacktrace.Propagate(
+				sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "gas fee cap %s is lower than the base fee %s", gasFeeCap, baseFee),
+				"failed to pay the base fee of transaction %d", i,
+			)
+		}
+
+		// the transactions rejected on their intrinsic gas are counted before the fees are deducted
+		if err := evmkeeper.CheckIntrinsicGas(ctx, txData, homestead, istanbul); err != nil {
+			if !simulate && !ctx.IsReCheckTx() {
+				evmkeeper.EmitIntrinsicGasFailureMetrics()
+			}
+			return ctx, stacktrace.Propagate(err, "failed to check the intrinsic gas of transaction %d", i)
+		}
+
+		feePayer := msgEthTx.GetFrom()
+		if feeGranter != nil {
+			fees := sdk.Coins{sdk.NewCoin(evmDenom, sdk.NewIntFromBigInt(evmtypes.EffectiveFee(txData, baseFee)))}
+			if err := egcd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fees, []sdk.Msg{msgEthTx}); err != nil {
+				return ctx, stacktrace.Propagate(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+			}
+
+			feePayer = feeGranter
+			egcd.evmKeeper.SetFeePayerTransient(ctx, msgEthTx.AsTransaction().Hash(), feePayer)
+		}
+
+		fees, err := evmkeeper.DeductTxCostsFromFeePayer(
 			ctx,
 			egcd.bankKeeper,
 			egcd.ak,
+			feePayer,
 			*msgEthTx,
 			txData,
 			evmDenom,
+			baseFee,
 			homestead,
 			istanbul,
 		)
 		if err != nil {
-			return ctx, stacktrace.Propagate(err, "failed to deduct transaction costs from user balance")
+			return ctx, stacktrace.Propagate(err, "failed to deduct transaction costs from fee payer balance")
 		}
 
 		events = append(events, sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyFee, fees.String())))
@@ -353,7 +455,7 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			)
 		}
 
-		coreMsg, err := msgEthTx.AsMessage(signer)
+		coreMsg, err := msgEthTx.AsMessage(signer, ctd.evmKeeper.GetBaseFee(ctx))
 		if err != nil {
 			return ctx, stacktrace.Propagate(
 				err,
@@ -543,6 +645,43 @@ func (esc EthSetupContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return newCtx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be GasTx")
 	}
 
-	newCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
-	return next(newCtx, tx, simulate)
+	newCtx, err = next(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	// the gas consumed by the ante handler is not charged, so that the gas used by the transaction
+	// is the sum of the gas used by the execution of its messages
+	newCtx.GasMeter().RefundGas(newCtx.GasMeter().GasConsumed(), "reset the ante handler gas consumption")
+	return newCtx, nil
+}
+
+// ethFeeGranter returns the fee granter of the Ethereum transaction, if any. The fee payer field of
+// the transaction can't be set, as the payer can't sign the transaction to authorize the payment,
+// so the fee payer must default to the sender of the first message.
+func ethFeeGranter(tx sdk.Tx) (sdk.AccAddress, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, nil
+	}
+
+	feeGranter := feeTx.FeeGranter()
+	if feeGranter == nil {
+		return nil, nil
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return feeGranter, nil
+	}
+
+	msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if ok && !msgEthTx.GetFrom().Equals(feeTx.FeePayer()) {
+		return nil, stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee payer %s must be the sender %s", feeTx.FeePayer(), msgEthTx.From),
+			"invalid fee payer",
+		)
+	}
+
+	return feeGranter, nil
 }
@@ -196,7 +196,7 @@ func (suite AnteTestSuite) TestEthNonceVerificationDecorator() {
 
 func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
 	dec := ante.NewEthGasConsumeDecorator(
-		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.EvmKeeper,
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper, suite.app.EvmKeeper,
 	)
 
 	addr := tests.GenerateAddress()
@@ -299,6 +299,19 @@ func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite AnteTestSuite) TestEthGasConsumeDecoratorSimulation() {
+	dec := ante.NewEthGasConsumeDecorator(
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper, suite.app.EvmKeeper,
+	)
+
+	// the simulation flag is recorded for the keeper, and reset on the next transaction
+	_, _ = dec.AnteHandle(suite.ctx, &invalidTx{}, true, nextFn)
+	suite.Require().True(suite.app.EvmKeeper.IsSimulationTransient(suite.ctx))
+
+	_, _ = dec.AnteHandle(suite.ctx, &invalidTx{}, false, nextFn)
+	suite.Require().False(suite.app.EvmKeeper.IsSimulationTransient(suite.ctx))
+}
+
 func (suite AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -0,0 +1,110 @@
+package ante_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func (suite AnteTestSuite) TestEthFeeGrant() {
+	addr, privKey := tests.NewAddrKey()
+	granter := tests.GenerateAddress()
+	to := tests.GenerateAddress()
+
+	// 100000 gas at a gas price of 1
+	fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(100000)))
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		feePayer sdk.AccAddress
+		checkTx  bool
+		expPass  bool
+	}{
+		{
+			"success - DeliverTx",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{}))
+			},
+			nil, false, true,
+		},
+		{
+			"success - CheckTx",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{}))
+			},
+			nil, true, true,
+		},
+		{
+			"success - sender as fee payer",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{}))
+			},
+			addr.Bytes(), false, true,
+		},
+		{
+			"fail - no allowance",
+			func(ctx sdk.Context) {},
+			nil, false, false,
+		},
+		{
+			"fail - allowance spend limit exceeded",
+			func(ctx sdk.Context) {
+				allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(1000)))}
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), addr.Bytes(), allowance))
+			},
+			nil, false, false,
+		},
+		{
+			"fail - fee payer other than the sender",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{}))
+			},
+			granter.Bytes(), false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.WithIsCheckTx(tc.checkTx).CacheContext()
+			suite.app.EvmKeeper.WithContext(ctx)
+
+			// the sender has no funds to pay the fees
+			suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr.Bytes()))
+			suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, granter.Bytes()))
+			suite.app.EvmKeeper.AddBalance(granter, big.NewInt(10000000000))
+
+			tc.malleate(ctx)
+
+			msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, nil, 100000, big.NewInt(1), nil, nil)
+			msg.From = addr.Hex()
+
+			txBuilder := suite.CreateTestTxBuilder(msg, privKey, 0, false)
+			txBuilder.SetFeeGranter(granter.Bytes())
+			if tc.feePayer != nil {
+				txBuilder.(interface{ SetFeePayer(sdk.AccAddress) }).SetFeePayer(tc.feePayer)
+			}
+
+			_, err := suite.anteHandler(ctx, txBuilder.GetTx(), false)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(
+					sdk.NewInt(10000000000).Sub(fees.AmountOf(evmtypes.DefaultEVMDenom)),
+					suite.app.BankKeeper.GetBalance(ctx, granter.Bytes(), evmtypes.DefaultEVMDenom).Amount,
+				)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr.Bytes()).IsZero())
+				// the leftover gas is refunded to the granter
+				suite.Require().Equal(sdk.AccAddress(granter.Bytes()), suite.app.EvmKeeper.GetFeePayerTransient(msg.AsTransaction().Hash()))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+}
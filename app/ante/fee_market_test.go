@@ -0,0 +1,151 @@
+package ante_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/palantir/stacktrace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/app/ante"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+func (suite AnteTestSuite) TestEthGasConsumeDecoratorBaseFee() {
+	addr := tests.GenerateAddress()
+	gasPrice := big.NewInt(10)
+	gasLimit := uint64(1000000)
+
+	testCases := []struct {
+		name      string
+		noBaseFee bool
+		baseFee   *big.Int
+		expPass   bool
+	}{
+		{"success - base fee not enforced", true, big.NewInt(20), true},
+		{"success - base fee not calculated", false, nil, true},
+		{"success - fee cap equal to base fee", false, big.NewInt(10), true},
+		{"success - fee cap above base fee", false, big.NewInt(1), true},
+		{"fail - fee cap below base fee", false, big.NewInt(11), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			dec := ante.NewEthGasConsumeDecorator(
+				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper, suite.app.EvmKeeper,
+			)
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.NoBaseFee = tc.noBaseFee
+			params.EnableHeight = 0
+			suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			if tc.baseFee != nil {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, tc.baseFee)
+			}
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			suite.app.EvmKeeper.WithContext(suite.ctx)
+			suite.app.EvmKeeper.AddBalance(addr, big.NewInt(20000000))
+
+			tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), gasLimit, gasPrice, nil, &ethtypes.AccessList{})
+			tx.From = addr.Hex()
+
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(true), tx, false, nextFn)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(stacktrace.RootCause(err), sdkerrors.ErrInsufficientFee), err.Error())
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			// the fees are charged at the effective gas price, i.e. the gas price
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), evmtypes.DefaultEVMDenom)
+			suite.Require().Equal(sdk.NewInt(10000000), balance.Amount)
+		})
+	}
+}
+
+func (suite AnteTestSuite) TestDynamicFeeTx() {
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	suite.SetupTest()
+
+	// the EVM requires the block proposer to be a validator
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	header := suite.ctx.BlockHeader()
+	header.ProposerAddress = priv.PubKey().Address()
+	ctx := suite.ctx.WithBlockHeader(header)
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(tests.GenerateAddress().Bytes()), priv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	suite.app.StakingKeeper.SetValidator(ctx, validator)
+
+	params := suite.app.FeeMarketKeeper.GetParams(ctx)
+	params.NoBaseFee = false
+	params.EnableHeight = 0
+	params.BaseFeeDestination = feemarkettypes.BASE_FEE_DESTINATION_BURN
+	suite.app.FeeMarketKeeper.SetParams(ctx, params)
+	suite.app.FeeMarketKeeper.SetBaseFee(ctx, big.NewInt(10))
+
+	suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr.Bytes()))
+	suite.app.EvmKeeper.WithContext(ctx)
+	suite.app.EvmKeeper.AddBalance(addr, big.NewInt(10000000))
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := suite.app.BankKeeper.GetBalance(ctx, feeCollector, evmtypes.DefaultEVMDenom).Amount
+
+	// the effective gas price is min(gasFeeCap, baseFee + gasTipCap) = 10 + 5
+	gasLimit := uint64(100000)
+	msg := &evmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   suite.app.EvmKeeper.ChainID(),
+		Nonce:     0,
+		GasTipCap: big.NewInt(5),
+		GasFeeCap: big.NewInt(100),
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     big.NewInt(1000),
+	}))
+	msg.From = addr.Hex()
+
+	tx := suite.CreateTestTx(msg, privKey, 0, false)
+	suite.Require().Equal(uint8(ethtypes.DynamicFeeTxType), msg.AsTransaction().Type())
+
+	ctx, err = suite.anteHandler(ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the fees are charged for the gas limit at the effective gas price
+	balance := suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), evmtypes.DefaultEVMDenom)
+	suite.Require().Equal(sdk.NewInt(10000000-100000*15), balance.Amount)
+
+	res, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(uint64(21000), res.GasUsed)
+
+	// the leftover gas is refunded at the effective gas price, and the base fee of the gas used is
+	// burned, leaving the priority fee to the fee collector
+	balance = suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), evmtypes.DefaultEVMDenom)
+	suite.Require().Equal(sdk.NewInt(10000000-21000*15-1000), balance.Amount)
+	suite.Require().Equal(
+		collected.Add(sdk.NewInt(21000*5)),
+		suite.app.BankKeeper.GetBalance(ctx, feeCollector, evmtypes.DefaultEVMDenom).Amount,
+	)
+	suite.Require().Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(ctx, to.Bytes(), evmtypes.DefaultEVMDenom).Amount)
+
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+}
@@ -136,6 +136,41 @@ func (suite *AnteTestSuite) CreateTestTxBuilder(
 	return txBuilder
 }
 
+// CreateTestMultiMsgTx is a helper function to create a tx with multiple ethereum messages, each
+// signed with the private key at the same index.
+func (suite *AnteTestSuite) CreateTestMultiMsgTx(msgs []*evmtypes.MsgEthereumTx, privs []cryptotypes.PrivKey) sdk.Tx {
+	option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+	suite.Require().NoError(err)
+
+	builder, ok := suite.clientCtx.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	suite.Require().True(ok)
+
+	builder.SetExtensionOptions(option)
+
+	var (
+		sdkMsgs  = make([]sdk.Msg, len(msgs))
+		fees     = sdk.NewCoins()
+		gasLimit uint64
+	)
+
+	for i, msg := range msgs {
+		suite.Require().NoError(msg.Sign(suite.ethSigner, tests.NewSigner(privs[i])))
+
+		txData, err := evmtypes.UnpackTxData(msg.Data)
+		suite.Require().NoError(err)
+
+		sdkMsgs[i] = msg
+		fees = fees.Add(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewIntFromBigInt(txData.Fee())))
+		gasLimit += msg.GetGas()
+	}
+
+	suite.Require().NoError(builder.SetMsgs(sdkMsgs...))
+	builder.SetFeeAmount(fees)
+	builder.SetGasLimit(gasLimit)
+
+	return builder.GetTx()
+}
+
 var _ sdk.Tx = &invalidTx{}
 
 type invalidTx struct{}
@@ -0,0 +1,223 @@
+// Package eip712 encodes the amino JSON sign document (StdSignDoc) of a Cosmos transaction as
+// EIP-712 typed data, so that the transactions can be signed by Ethereum wallets with the
+// `eth_signTypedData_v4` method.
+//
+// The types of the typed data are derived from the structure of the sign document: the JSON
+// strings, booleans and numbers are encoded as the `string`, `bool` and `int64` types, and the
+// JSON objects as struct types named after their path (e.g. `MsgValue` for the value of a message).
+// The null fields are omitted. As the typed data defines a single type per array, the messages of
+// a transaction, and the objects of any other array, must share the same structure.
+package eip712
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+// EIP-712 domain of the Cosmos transactions
+const (
+	DomainName              = "Cosmos Web3"
+	DomainVersion           = "1.0.0"
+	DomainVerifyingContract = "cosmos"
+	DomainSalt              = "0"
+)
+
+// PrimaryType is the type of the sign document within the typed data
+const PrimaryType = "Tx"
+
+// domainTypes defines the fields of the EIP-712 domain
+var domainTypes = []core.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "string"},
+	{Name: "salt", Type: "string"},
+}
+
+// typeNames defines the names of the well-known struct types of the sign document, indexed by
+// their path. The other struct types are named after the type of their parent and their field.
+var typeNames = map[string]string{
+	"fee":        "Fee",
+	"fee.amount": "Coin",
+	"msgs":       "Msg",
+	"msgs.value": "MsgValue",
+}
+
+// WrapTxToTypedData returns the EIP-712 typed data of the amino JSON sign document of a Cosmos
+// transaction, with the given EIP-155 chain ID in its domain.
+func WrapTxToTypedData(chainID uint64, signDocBytes []byte) (core.TypedData, error) {
+	var signDoc map[string]interface{}
+	if err := json.Unmarshal(signDocBytes, &signDoc); err != nil {
+		return core.TypedData{}, fmt.Errorf("failed to unmarshal sign document: %w", err)
+	}
+
+	types := core.Types{
+		"EIP712Domain": domainTypes,
+	}
+
+	message, err := addStructType(types, PrimaryType, "", signDoc)
+	if err != nil {
+		return core.TypedData{}, err
+	}
+
+	return core.TypedData{
+		Types:       types,
+		PrimaryType: PrimaryType,
+		Domain: core.TypedDataDomain{
+			Name:              DomainName,
+			Version:           DomainVersion,
+			ChainId:           (*math.HexOrDecimal256)(new(big.Int).SetUint64(chainID)),
+			VerifyingContract: DomainVerifyingContract,
+			Salt:              DomainSalt,
+		},
+		Message: message,
+	}, nil
+}
+
+// TypedDataSignBytes returns the bytes signed by the EIP-712 signature of the typed data, as
+// `"\x19\x01" ‖ domainSeparator ‖ hashStruct(message)`. The signature is computed over the
+// keccak256 hash of these bytes.
+func TypedDataSignBytes(typedData core.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the EIP-712 domain: %w", err)
+	}
+
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the EIP-712 message: %w", err)
+	}
+
+	return bytes.Join([][]byte{{0x19, 0x01}, domainSeparator, messageHash}, nil), nil
+}
+
+// ComputeTypedDataHash returns the EIP-712 hash of the typed data signed by the wallets.
+func ComputeTypedDataHash(typedData core.TypedData) ([]byte, error) {
+	signBytes, err := TypedDataSignBytes(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(signBytes), nil
+}
+
+// addStructType defines the struct type with the given name from the fields of the JSON object,
+// along with the types of its nested objects, and returns the object without its null fields.
+func addStructType(types core.Types, name, path string, object map[string]interface{}) (map[string]interface{}, error) {
+	keys := make([]string, 0, len(object))
+	for key, value := range object {
+		if value != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	fields := make([]core.Type, 0, len(keys))
+	message := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		fieldType, value, err := addFieldType(types, name, joinPath(path, key), key, object[key])
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, core.Type{Name: key, Type: fieldType})
+		message[key] = value
+	}
+
+	if existing, found := types[name]; found && !equalTypes(existing, fields) {
+		return nil, fmt.Errorf("objects of type %s have different structures", name)
+	}
+	types[name] = fields
+
+	return message, nil
+}
+
+// addFieldType returns the EIP-712 type of the field of the given struct type, defining the types
+// of its nested objects, and the field value without its null fields.
+func addFieldType(types core.Types, parent, path, key string, value interface{}) (string, interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return "string", v, nil
+	case bool:
+		return "bool", v, nil
+	case float64:
+		return "int64", v, nil
+	case map[string]interface{}:
+		name := structTypeName(parent, path, key)
+		object, err := addStructType(types, name, path, v)
+		return name, object, err
+	case []interface{}:
+		// the type of an empty array can't be derived from its elements
+		if len(v) == 0 {
+			return "string[]", v, nil
+		}
+
+		var elemType string
+		elems := make([]interface{}, len(v))
+		for i, elem := range v {
+			if _, isArray := elem.([]interface{}); isArray || elem == nil {
+				return "", nil, fmt.Errorf("unsupported element %v of array %s", elem, path)
+			}
+
+			t, value, err := addFieldType(types, parent, path, key, elem)
+			if err != nil {
+				return "", nil, err
+			}
+			if i > 0 && t != elemType {
+				return "", nil, fmt.Errorf("elements of array %s have different types", path)
+			}
+
+			elemType, elems[i] = t, value
+		}
+
+		return elemType + "[]", elems, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported value %v of field %s", value, path)
+	}
+}
+
+// structTypeName returns the name of the struct type of the object at the given path.
+func structTypeName(parent, path, key string) string {
+	if name, found := typeNames[path]; found {
+		return name
+	}
+
+	name := parent
+	for _, word := range strings.Split(key, "_") {
+		if word != "" {
+			name += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return name
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func equalTypes(a, b []core.Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package eip712
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/stretchr/testify/require"
+)
+
+const signDoc = `{
+	"account_number": "8",
+	"chain_id": "ethermint_9000-1",
+	"fee": {"amount": [{"amount": "200000", "denom": "aphoton"}], "gas": "200000"},
+	"memo": "",
+	"msgs": [
+		{
+			"type": "cosmos-sdk/MsgSend",
+			"value": {
+				"amount": [{"amount": "1", "denom": "aphoton"}],
+				"from_address": "ethm1tjm5w4zxvlg2dqamf6s3ak8dfnm2hg7yt4svhk",
+				"to_address": "ethm1s6f6t4u3yqnx4v6vqxr2sl3xntz8zmw0tsc9g5"
+			}
+		}
+	],
+	"sequence": "1",
+	"timeout_height": null
+}`
+
+func TestWrapTxToTypedData(t *testing.T) {
+	typedData, err := WrapTxToTypedData(9000, []byte(signDoc))
+	require.NoError(t, err)
+
+	require.Equal(t, PrimaryType, typedData.PrimaryType)
+	require.Equal(t, int64(9000), (*big.Int)(typedData.Domain.ChainId).Int64())
+	require.Equal(t, []core.Type{
+		{Name: "account_number", Type: "string"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: "Fee"},
+		{Name: "memo", Type: "string"},
+		{Name: "msgs", Type: "Msg[]"},
+		{Name: "sequence", Type: "string"},
+	}, typedData.Types[PrimaryType])
+	require.Equal(t, []core.Type{
+		{Name: "amount", Type: "Coin[]"},
+		{Name: "gas", Type: "string"},
+	}, typedData.Types["Fee"])
+	require.Equal(t, []core.Type{
+		{Name: "amount", Type: "string"},
+		{Name: "denom", Type: "string"},
+	}, typedData.Types["Coin"])
+	require.Equal(t, []core.Type{
+		{Name: "type", Type: "string"},
+		{Name: "value", Type: "MsgValue"},
+	}, typedData.Types["Msg"])
+	require.Equal(t, []core.Type{
+		{Name: "amount", Type: "MsgValueAmount[]"},
+		{Name: "from_address", Type: "string"},
+		{Name: "to_address", Type: "string"},
+	}, typedData.Types["MsgValue"])
+	require.Equal(t, typedData.Types["Coin"], typedData.Types["MsgValueAmount"])
+
+	// the null fields are omitted
+	require.NotContains(t, typedData.Message, "timeout_height")
+
+	hash, err := ComputeTypedDataHash(typedData)
+	require.NoError(t, err)
+
+	signBytes, err := TypedDataSignBytes(typedData)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x19, 0x01}, signBytes[:2])
+	require.Equal(t, crypto.Keccak256(signBytes), hash)
+
+	// the hash depends on the chain ID of the domain
+	typedData, err = WrapTxToTypedData(1, []byte(signDoc))
+	require.NoError(t, err)
+	otherHash, err := ComputeTypedDataHash(typedData)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+}
+
+func TestWrapTxToTypedDataErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		signDoc string
+	}{
+		{"invalid JSON", `{"msgs": [`},
+		{"not an object", `[]`},
+		{"messages with different structures", `{"msgs": [{"type": "a", "value": {"x": "1"}}, {"type": "b", "value": {"y": "1"}}]}`},
+		{"array with different element types", `{"values": ["1", true]}`},
+		{"nested arrays", `{"values": [["1"]]}`},
+		{"null array element", `{"values": [null]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := WrapTxToTypedData(9000, []byte(tc.signDoc))
+			require.Error(t, err)
+		})
+	}
+}
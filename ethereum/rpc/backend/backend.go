@@ -54,8 +54,13 @@ type Backend interface {
 	GetCoinbase() (sdk.AccAddress, error)
 	GetTransactionByHash(txHash common.Hash) (*types.RPCTransaction, error)
 	GetTxByEthHash(txHash common.Hash) (*tmrpctypes.ResultTx, error)
-	EstimateGas(args evmtypes.CallArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error)
+	GetTxByTxIndex(height int64, index uint) (*tmrpctypes.ResultTx, error)
+	EstimateGas(args evmtypes.CallArgs, blockNrOptional *types.BlockNumber, overrides *evmtypes.StateOverride) (hexutil.Uint64, error)
 	RPCGasCap() uint64
+	SuggestGasPrice() (*big.Int, error)
+	SuggestGasTipCap() (*big.Int, error)
+	BaseFee(height int64) (*big.Int, error)
+	FeeHistory(blockCount uint64, lastBlock types.BlockNumber, rewardPercentiles []float64) (*types.FeeHistoryResult, error)
 }
 
 var _ Backend = (*EVMBackend)(nil)
@@ -68,6 +73,7 @@ type EVMBackend struct {
 	logger      log.Logger
 	chainID     *big.Int
 	cfg         config.Config
+	gpo         *gasPriceOracle
 }
 
 // NewEVMBackend creates a new EVMBackend instance
@@ -89,6 +95,7 @@ func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Cont
 		logger:      logger.With("module", "evm-backend"),
 		chainID:     chainID,
 		cfg:         *appConf,
+		gpo:         &gasPriceOracle{},
 	}
 }
 
@@ -216,16 +223,39 @@ func (e *EVMBackend) EthBlockFromTendermint(
 ) (map[string]interface{}, error) {
 	ethRPCTxs := []interface{}{}
 
+	resBlockResult, err := e.clientCtx.Client.BlockResults(e.ctx, &block.Height)
+	if err != nil {
+		e.logger.Debug("EthBlockFromTendermint block result not found", "height", block.Height, "error", err.Error())
+		return nil, err
+	}
+
 	for i, txBz := range block.Txs {
+		if i >= len(resBlockResult.TxsResults) {
+			break
+		}
+
+		// the messages of the failed transactions are not executed
+		parsedTxs, err := types.ParseTxResult(resBlockResult.TxsResults[i])
+		if err != nil {
+			e.logger.Debug("failed to parse transaction result in block", "height", block.Height, "error", err.Error())
+			continue
+		}
+		if len(parsedTxs) == 0 {
+			continue
+		}
+
 		tx, err := e.clientCtx.TxConfig.TxDecoder()(txBz)
 		if err != nil {
 			e.logger.Debug("failed to decode transaction in block", "height", block.Height, "error", err.Error())
 			continue
 		}
 
-		for _, msg := range tx.GetMsgs() {
-			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		for _, parsedTx := range parsedTxs {
+			if parsedTx.MsgIndex >= len(tx.GetMsgs()) {
+				break
+			}
 
+			ethMsg, ok := tx.GetMsgs()[parsedTx.MsgIndex].(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
 			}
@@ -255,7 +285,7 @@ func (e *EVMBackend) EthBlockFromTendermint(
 				hash,
 				common.BytesToHash(block.Hash()),
 				uint64(block.Height),
-				uint64(i),
+				parsedTx.EthTxIndex,
 			)
 			if err != nil {
 				e.logger.Debug("NewTransactionFromData for receipt failed", "hash", hash.Hex(), "error", err.Error())
@@ -287,17 +317,11 @@ func (e *EVMBackend) EthBlockFromTendermint(
 
 	validatorAddr := common.BytesToAddress(addr)
 
-	gasLimit, err := types.BlockMaxGasFromConsensusParams(types.ContextWithHeight(block.Height), e.clientCtx)
+	gasLimit, err := types.BlockMaxGasFromConsensusParams(types.ContextWithHeight(block.Height), e.clientCtx, block.Height)
 	if err != nil {
 		e.logger.Error("failed to query consensus params", "error", err.Error())
 	}
 
-	resBlockResult, err := e.clientCtx.Client.BlockResults(e.ctx, &block.Height)
-	if err != nil {
-		e.logger.Debug("EthBlockFromTendermint block result not found", "height", block.Height, "error", err.Error())
-		return nil, err
-	}
-
 	gasUsed := uint64(0)
 
 	for _, txsResult := range resBlockResult.TxsResults {
@@ -372,11 +396,20 @@ func (e *EVMBackend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, erro
 // It returns an error if there's an encoding error.
 // If no logs are found for the tx hash, the error is nil.
 func (e *EVMBackend) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error) {
-	tx, err := e.clientCtx.Client.Tx(e.ctx, txHash.Bytes(), false)
+	res, err := e.GetTxByEthHash(txHash)
 	if err != nil {
 		return nil, err
 	}
-	return TxLogsFromEvents(e.clientCtx.Codec, tx.TxResult.Events), nil
+
+	// the cosmos transaction may contain the logs of multiple ethereum transactions
+	logs := []*ethtypes.Log{}
+	for _, log := range TxLogsFromEvents(e.clientCtx.Codec, res.TxResult.Events) {
+		if log.TxHash == txHash {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs, nil
 }
 
 // PendingTransactions returns the transactions that are in the transaction pool
@@ -492,25 +525,23 @@ func (e *EVMBackend) GetTransactionByHash(txHash common.Hash) (*types.RPCTransac
 		}
 
 		for _, tx := range txs {
-			msg, err := evmtypes.UnwrapEthereumMsg(tx)
+			msg, err := evmtypes.UnwrapEthereumMsg(tx, txHash)
 			if err != nil {
 				// not ethereum tx
 				continue
 			}
 
-			if msg.Hash == txHash.Hex() {
-				rpctx, err := types.NewTransactionFromMsg(
-					msg,
-					common.Hash{},
-					uint64(0),
-					uint64(0),
-					e.chainID,
-				)
-				if err != nil {
-					return nil, err
-				}
-				return rpctx, nil
+			rpctx, err := types.NewTransactionFromMsg(
+				msg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				e.chainID,
+			)
+			if err != nil {
+				return nil, err
 			}
+			return rpctx, nil
 		}
 
 		e.logger.Debug("tx not found", "hash", txHash.Hex())
@@ -529,7 +560,17 @@ func (e *EVMBackend) GetTransactionByHash(txHash common.Hash) (*types.RPCTransac
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
 
-	msg, err := evmtypes.UnwrapEthereumMsg(&tx)
+	parsedTxs, err := types.ParseTxResult(&res.TxResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tx events: %w", err)
+	}
+
+	parsedTx := parsedTxs.GetTxByHash(txHash)
+	if parsedTx == nil {
+		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", txHash.Hex())
+	}
+
+	msg, err := evmtypes.UnwrapEthereumMsg(&tx, txHash)
 	if err != nil {
 		e.logger.Debug("invalid tx", "error", err.Error())
 		return nil, err
@@ -539,7 +580,7 @@ func (e *EVMBackend) GetTransactionByHash(txHash common.Hash) (*types.RPCTransac
 		msg,
 		common.BytesToHash(resBlock.Block.Hash()),
 		uint64(res.Height),
-		uint64(res.Index),
+		parsedTx.EthTxIndex,
 		e.chainID,
 	)
 }
@@ -559,6 +600,23 @@ func (e *EVMBackend) GetTxByEthHash(hash common.Hash) (*tmrpctypes.ResultTx, err
 	return resTxs.Txs[0], nil
 }
 
+// GetTxByTxIndex uses `/tx_query` to find the transaction containing the ethereum transaction at the
+// given index of the block
+func (e *EVMBackend) GetTxByTxIndex(height int64, index uint) (*tmrpctypes.ResultTx, error) {
+	query := fmt.Sprintf("tx.height=%d AND %s.%s=%d",
+		height, evmtypes.TypeMsgEthereumTx,
+		evmtypes.AttributeKeyTxIndex, index,
+	)
+	resTxs, err := e.clientCtx.Client.TxSearch(e.ctx, query, false, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(resTxs.Txs) == 0 {
+		return nil, errors.Errorf("ethereum tx not found for block %d index %d", height, index)
+	}
+	return resTxs.Txs[0], nil
+}
+
 func (e *EVMBackend) SendTransaction(args types.SendTxArgs) (common.Hash, error) {
 	// Look up the wallet containing the requested signer
 	_, err := e.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.From.Bytes()))
@@ -650,7 +708,10 @@ func (e *EVMBackend) SendTransaction(args types.SendTxArgs) (common.Hash, error)
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *EVMBackend) EstimateGas(args evmtypes.CallArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error) {
+// The optional state overrides are applied before the call is executed.
+func (e *EVMBackend) EstimateGas(
+	args evmtypes.CallArgs, blockNrOptional *types.BlockNumber, overrides *evmtypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := types.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -662,6 +723,12 @@ func (e *EVMBackend) EstimateGas(args evmtypes.CallArgs, blockNrOptional *types.
 	}
 
 	req := evmtypes.EthCallRequest{Args: bz, GasCap: e.RPCGasCap()}
+	if overrides != nil {
+		req.Overrides, err = json.Marshal(overrides)
+		if err != nil {
+			return 0, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -0,0 +1,341 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/tharsis/ethermint/ethereum/rpc/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// maxFeeHistory is the maximum number of blocks that can be retrieved for a fee history request.
+const maxFeeHistory = 1024
+
+// txGasAndReward is the gas used and the effective tip of a transaction in a block.
+type txGasAndReward struct {
+	gasUsed uint64
+	reward  *big.Int
+}
+
+type sortGasAndReward []txGasAndReward
+
+func (s sortGasAndReward) Len() int      { return len(s) }
+func (s sortGasAndReward) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s sortGasAndReward) Less(i, j int) bool {
+	return s[i].reward.Cmp(s[j].reward) < 0
+}
+
+// SuggestGasTipCap returns a priority fee suggestion for EIP-1559 transactions, computed by
+// the gas price oracle from the effective tips of the recent blocks.
+func (e *EVMBackend) SuggestGasTipCap() (*big.Int, error) {
+	blockNum, err := e.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.suggestGasTip(int64(blockNum))
+}
+
+// FeeHistory returns the base fee per gas, the gas used ratio and the effective tip percentiles
+// of the given number of blocks up to lastBlock. The base fee of the block following lastBlock is
+// also returned.
+// NOTE: the reward percentiles are computed as in go-ethereum. For the canonical code refer to:
+// https://github.com/ethereum/go-ethereum/blob/v1.10.8/eth/gasprice/feehistory.go
+func (e *EVMBackend) FeeHistory(
+	blockCount uint64, lastBlock types.BlockNumber, rewardPercentiles []float64,
+) (*types.FeeHistoryResult, error) {
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile #%d: %f > #%d: %f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+
+	if blockCount > maxFeeHistory {
+		blockCount = maxFeeHistory
+	}
+
+	currentBlock, err := e.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	last := int64(lastBlock)
+	if lastBlock < 0 || last > int64(currentBlock) {
+		// latest and pending blocks, or a block that isn't available yet
+		last = int64(currentBlock)
+	}
+
+	if blockCount == 0 || last < 1 {
+		return &types.FeeHistoryResult{OldestBlock: (*hexutil.Big)(new(big.Int))}, nil
+	}
+
+	if uint64(last) < blockCount {
+		blockCount = uint64(last)
+	}
+	oldest := last - int64(blockCount) + 1
+
+	result := &types.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(oldest)),
+		BaseFee:      make([]*hexutil.Big, blockCount+1),
+		GasUsedRatio: make([]float64, blockCount),
+	}
+	if len(rewardPercentiles) > 0 {
+		result.Reward = make([][]*hexutil.Big, blockCount)
+	}
+
+	// the blocks kept in the fee market history don't require the historical states
+	history := e.blockFeesHistory(oldest, last+1)
+
+	for i := 0; i < int(blockCount); i++ {
+		height := oldest + int64(i)
+
+		var (
+			baseFee *big.Int
+			gasUsed uint64
+		)
+
+		if blockFees, found := history[height]; found {
+			baseFee, gasUsed = blockFees.BaseFee.BigInt(), blockFees.GasUsed
+		} else {
+			// the base fee used for a block is the one stored on the state of its parent
+			baseFee, err = e.blockBaseFee(height)
+			if err != nil {
+				return nil, err
+			}
+
+			gasUsed, err = e.blockGasUsed(height)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result.BaseFee[i] = (*hexutil.Big)(baseFee)
+
+		// the gas limit of the block is the one of the consensus params at its height
+		gasLimit, err := types.BlockMaxGasFromConsensusParams(e.ctx, e.clientCtx, height)
+		if err != nil {
+			e.logger.Debug("failed to query consensus params", "height", height, "error", err.Error())
+		}
+		if gasLimit > 0 {
+			result.GasUsedRatio[i] = float64(gasUsed) / float64(gasLimit)
+		}
+
+		if result.Reward != nil {
+			result.Reward[i], err = e.blockRewards(height, baseFee, rewardPercentiles)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// base fee of the block following the last one
+	nextBaseFee, err := e.nextBlockBaseFee(last, history)
+	if err != nil {
+		return nil, err
+	}
+	result.BaseFee[blockCount] = (*hexutil.Big)(nextBaseFee)
+
+	return result, nil
+}
+
+// blockFeesHistory returns the base fee and the gas used of the blocks within the given height
+// range that are kept in the x/feemarket history of the recent blocks, indexed by height. It
+// returns an empty history if the query fails, so that the blocks are queried on their own.
+func (e *EVMBackend) blockFeesHistory(from, to int64) map[int64]feemarkettypes.BlockFees {
+	res, err := e.queryClient.FeeMarket.BaseFeeHistory(e.ctx, &feemarkettypes.QueryBaseFeeHistoryRequest{
+		From:       from,
+		To:         to,
+		Pagination: &query.PageRequest{Limit: uint64(to - from + 1)},
+	})
+	if err != nil {
+		e.logger.Debug("failed to query the base fee history", "from", from, "to", to, "error", err.Error())
+		return nil
+	}
+
+	history := make(map[int64]feemarkettypes.BlockFees, len(res.History))
+	for _, blockFees := range res.History {
+		history[blockFees.Height] = blockFees
+	}
+
+	return history
+}
+
+// nextBlockBaseFee returns the base fee of the block following the given height, from the history
+// if kept, or stored on the state of the block otherwise. It returns zero if the base fee is not
+// enabled.
+func (e *EVMBackend) nextBlockBaseFee(height int64, history map[int64]feemarkettypes.BlockFees) (*big.Int, error) {
+	if blockFees, found := history[height+1]; found {
+		return blockFees.BaseFee.BigInt(), nil
+	}
+
+	baseFee, err := e.BaseFee(height)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return new(big.Int), nil
+	}
+	return baseFee, nil
+}
+
+// blockBaseFee returns the base fee used for the block at the given height, which is the one
+// stored on the state of its parent. It returns zero if the base fee is not enabled.
+func (e *EVMBackend) blockBaseFee(height int64) (*big.Int, error) {
+	if height <= 1 {
+		return new(big.Int), nil
+	}
+
+	baseFee, err := e.BaseFee(height - 1)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return new(big.Int), nil
+	}
+	return baseFee, nil
+}
+
+// blockGasUsed returns the gas used by the block at the given height, as stored by x/feemarket on
+// the state of the block.
+func (e *EVMBackend) blockGasUsed(height int64) (uint64, error) {
+	resBlockGas, err := e.queryClient.FeeMarket.BlockGas(types.ContextWithHeight(height), &feemarkettypes.QueryBlockGasRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(resBlockGas.Gas), nil
+}
+
+// blockRewards returns the effective tips at the given percentiles of the block gas used by the
+// Ethereum transactions of the block at the given height.
+func (e *EVMBackend) blockRewards(height int64, baseFee *big.Int, rewardPercentiles []float64) ([]*hexutil.Big, error) {
+	resBlock, err := e.clientCtx.Client.Block(e.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlockResult, err := e.clientCtx.Client.BlockResults(e.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sorter     sortGasAndReward
+		txsResults = resBlockResult.TxsResults
+		decodeTx   = e.clientCtx.TxConfig.TxDecoder()
+	)
+
+	for i, txBz := range resBlock.Block.Txs {
+		if i >= len(txsResults) || txsResults[i].Code != 0 {
+			continue
+		}
+
+		tx, err := decodeTx(txBz)
+		if err != nil {
+			e.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+
+		txRewards, err := txGasAndRewards(tx, txsResults[i], baseFee)
+		if err != nil {
+			e.logger.Debug("failed to parse transaction result", "height", height, "error", err.Error())
+			continue
+		}
+
+		sorter = append(sorter, txRewards...)
+	}
+
+	return percentileRewards(sorter, rewardPercentiles), nil
+}
+
+// percentileRewards returns the effective tips at the given percentiles, in ascending order, of
+// the gas used by the given transactions: the tip at a percentile is the one of the transaction
+// whose gas, with the gas of the transactions of lower tips, reaches the percentile of the total
+// gas used. The tips are zero if there are no transactions.
+func percentileRewards(txRewards sortGasAndReward, rewardPercentiles []float64) []*hexutil.Big {
+	rewards := make([]*hexutil.Big, len(rewardPercentiles))
+	if len(txRewards) == 0 {
+		for i := range rewards {
+			rewards[i] = (*hexutil.Big)(new(big.Int))
+		}
+		return rewards
+	}
+
+	sorted := make(sortGasAndReward, len(txRewards))
+	copy(sorted, txRewards)
+	sort.Stable(sorted)
+
+	var txsGasUsed uint64
+	for _, txReward := range sorted {
+		txsGasUsed += txReward.gasUsed
+	}
+
+	var txIndex int
+	sumGasUsed := sorted[0].gasUsed
+
+	for i, p := range rewardPercentiles {
+		thresholdGasUsed := uint64(float64(txsGasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(sorted)-1 {
+			txIndex++
+			sumGasUsed += sorted[txIndex].gasUsed
+		}
+		rewards[i] = (*hexutil.Big)(sorted[txIndex].reward)
+	}
+
+	return rewards
+}
+
+// txGasAndRewards returns the gas used and the effective tip of each Ethereum transaction of a Cosmos
+// transaction. The gas used by each Ethereum transaction is parsed from the events of the
+// transaction result, as the gas used by the Cosmos transaction is the sum of the gas used by its
+// messages.
+func txGasAndRewards(tx sdk.Tx, result *abci.ResponseDeliverTx, baseFee *big.Int) (sortGasAndReward, error) {
+	parsedTxs, err := types.ParseTxResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rewards  sortGasAndReward
+		msgIndex int
+	)
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		if msgIndex >= len(parsedTxs) {
+			return nil, fmt.Errorf("missing %s event of message %d", evmtypes.EventTypeEthereumTx, msgIndex)
+		}
+		gasUsed := parsedTxs[msgIndex].GasUsed
+		msgIndex++
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack tx data of %s: %w", ethMsg.Hash, err)
+		}
+
+		reward := evmtypes.EffectiveGasTip(txData, baseFee)
+		if reward.Sign() < 0 {
+			reward = new(big.Int)
+		}
+
+		rewards = append(rewards, txGasAndReward{gasUsed: gasUsed, reward: reward})
+	}
+
+	return rewards, nil
+}
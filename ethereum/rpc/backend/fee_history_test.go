@@ -0,0 +1,149 @@
+package backend
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// testTx is a cosmos transaction with the given messages.
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx testTx) ValidateBasic() error { return nil }
+
+func ethereumTxEvent(hash string, gasUsed uint64) abci.Event {
+	return abci.Event{
+		Type: evmtypes.EventTypeEthereumTx,
+		Attributes: []abci.EventAttribute{
+			{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(hash)},
+			{Key: []byte(evmtypes.AttributeKeyTxGasUsed), Value: []byte(strconv.FormatUint(gasUsed, 10))},
+		},
+	}
+}
+
+func TestTxGasAndRewards(t *testing.T) {
+	to := common.BigToAddress(big.NewInt(1))
+	ethMsg := func(gasPrice int64) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(big.NewInt(9000), 0, &to, nil, 100000, big.NewInt(gasPrice), nil, nil)
+	}
+	baseFee := big.NewInt(10)
+
+	testCases := []struct {
+		msg        string
+		tx         sdk.Tx
+		result     *abci.ResponseDeliverTx
+		expRewards sortGasAndReward
+		expPass    bool
+	}{
+		{
+			"multiple ethereum txs",
+			testTx{msgs: []sdk.Msg{ethMsg(15), ethMsg(30)}},
+			&abci.ResponseDeliverTx{
+				// the gas used by the cosmos transaction is the sum of the gas used by its messages
+				GasUsed: 51000,
+				Events:  []abci.Event{ethereumTxEvent("0x01", 21000), ethereumTxEvent("0x02", 30000)},
+			},
+			sortGasAndReward{
+				{gasUsed: 21000, reward: big.NewInt(5)},
+				{gasUsed: 30000, reward: big.NewInt(20)},
+			},
+			true,
+		},
+		{
+			"gas price below the base fee",
+			testTx{msgs: []sdk.Msg{ethMsg(5)}},
+			&abci.ResponseDeliverTx{GasUsed: 21000, Events: []abci.Event{ethereumTxEvent("0x01", 21000)}},
+			sortGasAndReward{{gasUsed: 21000, reward: new(big.Int)}},
+			true,
+		},
+		{
+			"non ethereum messages",
+			testTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}},
+			&abci.ResponseDeliverTx{GasUsed: 50000},
+			nil,
+			true,
+		},
+		{
+			"missing ethereum tx event",
+			testTx{msgs: []sdk.Msg{ethMsg(15), ethMsg(30)}},
+			&abci.ResponseDeliverTx{GasUsed: 51000, Events: []abci.Event{ethereumTxEvent("0x01", 21000)}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			rewards, err := txGasAndRewards(tc.tx, tc.result, baseFee)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expRewards, rewards)
+		})
+	}
+}
+
+func TestPercentileRewards(t *testing.T) {
+	rewards := func(values ...int64) []*hexutil.Big {
+		res := make([]*hexutil.Big, len(values))
+		for i, v := range values {
+			res[i] = (*hexutil.Big)(big.NewInt(v))
+		}
+		return res
+	}
+
+	// the transactions use 10%, 20%, 30% and 40% of the block gas, unsorted
+	txRewards := sortGasAndReward{
+		{gasUsed: 30000, reward: big.NewInt(3)},
+		{gasUsed: 10000, reward: big.NewInt(1)},
+		{gasUsed: 40000, reward: big.NewInt(4)},
+		{gasUsed: 20000, reward: big.NewInt(2)},
+	}
+
+	testCases := []struct {
+		msg         string
+		txRewards   sortGasAndReward
+		percentiles []float64
+		expRewards  []*hexutil.Big
+	}{
+		{"no percentiles", txRewards, []float64{}, rewards()},
+		{"empty block", nil, []float64{0, 50, 100}, rewards(0, 0, 0)},
+		{"0 and 100 percentiles", txRewards, []float64{0, 100}, rewards(1, 4)},
+		{"percentiles at the tx boundaries", txRewards, []float64{10, 30, 60, 100}, rewards(1, 2, 3, 4)},
+		{"percentiles within the txs", txRewards, []float64{5, 11, 31, 61}, rewards(1, 2, 3, 4)},
+		{"single tx", sortGasAndReward{{gasUsed: 21000, reward: big.NewInt(7)}}, []float64{0, 50, 100}, rewards(7, 7, 7)},
+		{
+			"txs without gas used",
+			sortGasAndReward{{gasUsed: 0, reward: big.NewInt(2)}, {gasUsed: 0, reward: big.NewInt(1)}},
+			[]float64{0, 100},
+			rewards(1, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			require.Equal(t, tc.expRewards, percentileRewards(tc.txRewards, tc.percentiles))
+		})
+	}
+
+	// the transactions are not reordered
+	require.Equal(t, big.NewInt(3), txRewards[0].reward)
+}
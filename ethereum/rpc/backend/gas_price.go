@@ -0,0 +1,231 @@
+package backend
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/ethereum/rpc/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// gasPriceSampleNumber is the number of transactions sampled in a block by the gas price oracle
+const gasPriceSampleNumber = 3
+
+// gasPriceOracle caches the last gas tip computed by the oracle so that the blocks are only
+// sampled once per height.
+// NOTE: the sampling is adapted from the go-ethereum gas price oracle. For the canonical code refer to:
+// https://github.com/ethereum/go-ethereum/blob/v1.10.3/eth/gasprice/gasprice.go
+type gasPriceOracle struct {
+	mu         sync.Mutex
+	lastHeight int64
+	lastTip    *big.Int
+}
+
+// SuggestGasPrice returns a gas price that gives a newly created transaction a very high chance
+// to be included in the following blocks. The effective gas tips of the most recent blocks are
+// sampled and the configured percentile is picked. The current base fee is then added to it when
+// EIP-1559 is enabled on x/feemarket. The result is never lower than the configured default gas
+// price nor the node's minimum gas price for the EVM denomination.
+func (e *EVMBackend) SuggestGasPrice() (*big.Int, error) {
+	blockNum, err := e.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	height := int64(blockNum)
+
+	tip, err := e.suggestGasTip(height)
+	if err != nil {
+		return nil, err
+	}
+
+	price := new(big.Int).Set(tip)
+
+	baseFee, err := e.BaseFee(height)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee != nil {
+		price.Add(price, baseFee)
+	}
+
+	minGasPrice, err := e.minGasPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	if price.Cmp(minGasPrice) < 0 {
+		price.Set(minGasPrice)
+	}
+
+	return price, nil
+}
+
+// BaseFee returns the EIP-1559 base fee stored by x/feemarket at the given height, which is the
+// base fee of the following block. It returns nil if the base fee is not enabled.
+func (e *EVMBackend) BaseFee(height int64) (*big.Int, error) {
+	res, err := e.queryClient.FeeMarket.BaseFee(types.ContextWithHeight(height), &feemarkettypes.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.BaseFee.IsNil() || !res.BaseFee.IsPositive() {
+		return nil, nil
+	}
+
+	return res.BaseFee.BigInt(), nil
+}
+
+// suggestGasTip samples the lowest effective gas tips of the blocks up to the given height and
+// returns the configured percentile of them. The result is cached for the given height.
+func (e *EVMBackend) suggestGasTip(height int64) (*big.Int, error) {
+	e.gpo.mu.Lock()
+	defer e.gpo.mu.Unlock()
+
+	lastTip := e.gpo.lastTip
+	if lastTip == nil {
+		lastTip = new(big.Int)
+	}
+
+	if e.gpo.lastHeight == height && e.gpo.lastTip != nil {
+		return new(big.Int).Set(lastTip), nil
+	}
+
+	blocks := e.cfg.JSONRPC.GasPriceBlocks
+	if blocks < 1 {
+		blocks = 1
+	}
+
+	percentile := e.cfg.JSONRPC.GasPricePercentile
+	if percentile < 0 {
+		percentile = 0
+	} else if percentile > 100 {
+		percentile = 100
+	}
+
+	var (
+		sampled int
+		limit   = blocks
+		tips    []*big.Int
+	)
+
+	for number := height; sampled < limit && number > 0; number-- {
+		blockTips, err := e.blockGasTips(number, gasPriceSampleNumber)
+		if err != nil {
+			return nil, err
+		}
+		sampled++
+
+		// Nothing returned if the block is empty or all the transactions pay no tip.
+		// In these cases, use the latest calculated tip for sampling.
+		if len(blockTips) == 0 {
+			blockTips = []*big.Int{lastTip}
+		}
+
+		// Besides, in order to collect enough data for sampling, if nothing
+		// meaningful returned, try to query more blocks. But the maximum
+		// is 2*blocks.
+		if len(blockTips) == 1 && limit < 2*blocks {
+			limit++
+		}
+
+		tips = append(tips, blockTips...)
+	}
+
+	tip := lastTip
+	if len(tips) > 0 {
+		sort.Sort(bigIntArray(tips))
+		tip = tips[(len(tips)-1)*percentile/100]
+	}
+
+	e.gpo.lastHeight = height
+	e.gpo.lastTip = tip
+
+	return new(big.Int).Set(tip), nil
+}
+
+// blockGasTips returns the lowest effective gas tips, up to the given limit, of the Ethereum
+// transactions included in the block at the given height, sorted in ascending order.
+func (e *EVMBackend) blockGasTips(height int64, limit int) ([]*big.Int, error) {
+	resBlock, err := e.clientCtx.Client.Block(e.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the base fee used for a block is the one stored on the state of its parent
+	var baseFee *big.Int
+	if height > 1 {
+		baseFee, err = e.BaseFee(height - 1)
+		if err != nil {
+			e.logger.Debug("failed to query base fee", "height", height-1, "error", err.Error())
+		}
+	}
+
+	var tips []*big.Int
+	for _, txBz := range resBlock.Block.Txs {
+		tx, err := e.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			e.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				e.logger.Debug("failed to unpack tx data", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			tip := evmtypes.EffectiveGasTip(txData, baseFee)
+			if tip.Cmp(common.Big1) <= 0 {
+				continue
+			}
+
+			tips = append(tips, tip)
+		}
+	}
+
+	sort.Sort(bigIntArray(tips))
+	if len(tips) > limit {
+		tips = tips[:limit]
+	}
+
+	return tips, nil
+}
+
+// minGasPrice returns the lowest gas price that the node suggests. It is the highest value between
+// the configured default gas price and the node's minimum gas price for the EVM denomination.
+func (e *EVMBackend) minGasPrice() (*big.Int, error) {
+	minGasPrice := new(big.Int).SetUint64(e.cfg.JSONRPC.GasPriceDefault)
+
+	minGasPrices := e.cfg.GetMinGasPrices()
+	if minGasPrices.IsZero() {
+		return minGasPrice, nil
+	}
+
+	res, err := e.queryClient.Params(e.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	nodeMinGasPrice := minGasPrices.AmountOf(res.Params.EvmDenom).Ceil().TruncateInt().BigInt()
+	if nodeMinGasPrice.Cmp(minGasPrice) > 0 {
+		return nodeMinGasPrice, nil
+	}
+
+	return minGasPrice, nil
+}
+
+type bigIntArray []*big.Int
+
+func (s bigIntArray) Len() int           { return len(s) }
+func (s bigIntArray) Less(i, j int) bool { return s[i].Cmp(s[j]) < 0 }
+func (s bigIntArray) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
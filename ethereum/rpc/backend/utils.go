@@ -22,8 +22,11 @@ import (
 // provided on the args
 func (e *EVMBackend) setTxDefaults(args types.SendTxArgs) (types.SendTxArgs, error) {
 	if args.GasPrice == nil {
-		// TODO: Suggest a gas price based on the previous included txs
-		args.GasPrice = (*hexutil.Big)(new(big.Int).SetUint64(e.RPCGasCap()))
+		price, err := e.SuggestGasPrice()
+		if err != nil {
+			return args, err
+		}
+		args.GasPrice = (*hexutil.Big)(price)
 	}
 
 	if args.Nonce == nil {
@@ -69,7 +72,7 @@ func (e *EVMBackend) setTxDefaults(args types.SendTxArgs) (types.SendTxArgs, err
 			AccessList: args.AccessList,
 		}
 		blockNr := types.NewBlockNumber(big.NewInt(0))
-		estimated, err := e.EstimateGas(callArgs, &blockNr)
+		estimated, err := e.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -116,29 +119,32 @@ func (e *EVMBackend) getAccountNonce(accAddr common.Address, pending bool, heigh
 	// add the uncommitted txs to the nonce counter
 	// only supports `MsgEthereumTx` style tx
 	for _, tx := range pendingTxs {
-		msg, err := evmtypes.UnwrapEthereumMsg(tx)
-		if err != nil {
-			// not ethereum tx
-			continue
-		}
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
 
-		sender, err := msg.GetSender(e.chainID)
-		if err != nil {
-			continue
-		}
-		if sender == accAddr {
-			nonce++
+			sender, err := ethMsg.GetSender(e.chainID)
+			if err != nil {
+				continue
+			}
+			if sender == accAddr {
+				nonce++
+			}
 		}
 	}
 
 	return nonce, nil
 }
 
-// TxLogsFromEvents parses ethereum logs from cosmos events
+// TxLogsFromEvents parses ethereum logs from cosmos events, i.e. the logs of the ethereum
+// transactions and the logs emitted outside of them by the precompiles.
 func TxLogsFromEvents(codec codec.Codec, events []abci.Event) []*ethtypes.Log {
 	logs := make([]*evmtypes.Log, 0)
 	for _, event := range events {
-		if event.Type != evmtypes.EventTypeTxLog {
+		if event.Type != evmtypes.EventTypeTxLog && event.Type != evmtypes.EventTypePrecompileLog {
 			continue
 		}
 		for _, attr := range event.Attributes {
@@ -0,0 +1,301 @@
+// Package middleware implements the access control and the limits of the JSON-RPC server: the
+// method allow and deny lists, the maximum batch request and response sizes, and the rate limits
+// per client IP. The rejected calls are counted by the `json_rpc_rejected_<reason>` telemetry
+// counters.
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/tharsis/ethermint/server/config"
+)
+
+// maxRequestSize is the maximum size of a request body, matching the one of the go-ethereum server.
+const maxRequestSize = 5 * 1024 * 1024
+
+// JSON-RPC error codes of the rejected requests
+const (
+	errCodeInvalidRequest   = -32600
+	errCodeMethodNotFound   = -32601
+	errCodeResponseTooLarge = -32003
+	errCodeLimitExceeded    = -32005
+)
+
+// reasons of the rejected requests, used as telemetry keys
+const (
+	reasonMethod       = "method"
+	reasonBatchSize    = "batch_size"
+	reasonRateLimit    = "rate_limit"
+	reasonResponseSize = "response_size"
+)
+
+// Error is the JSON-RPC error returned for a rejected request.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+
+	status int
+	reason string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+type errorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *Error          `json:"error"`
+}
+
+// call is the subset of the fields of a JSON-RPC call checked by the middleware.
+type call struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// Middleware checks the JSON-RPC requests against the configured method lists and limits before
+// they are served.
+type Middleware struct {
+	logger          log.Logger
+	allowed         map[string]bool
+	denied          map[string]bool
+	maxBatchSize    int
+	maxResponseSize int
+	limiter         *rateLimiter
+}
+
+// New creates a new JSON-RPC middleware from the given configuration.
+func New(logger log.Logger, cfg config.JSONRPCConfig) *Middleware {
+	m := &Middleware{
+		logger:          logger.With("module", "json-rpc-middleware"),
+		allowed:         make(map[string]bool),
+		denied:          make(map[string]bool),
+		maxBatchSize:    cfg.MaxBatchSize,
+		maxResponseSize: cfg.MaxResponseSize,
+	}
+
+	for _, method := range cfg.AllowedMethods {
+		m.allowed[method] = true
+	}
+
+	for _, method := range cfg.DeniedMethods {
+		m.denied[method] = true
+	}
+
+	if cfg.RateLimit > 0 {
+		m.limiter = newRateLimiter(cfg.RateLimit, cfg.RateBurst)
+	}
+
+	return m
+}
+
+// MethodAllowed returns true if the given method can be called.
+func (m *Middleware) MethodAllowed(method string) bool {
+	if m.denied[method] {
+		return false
+	}
+	return len(m.allowed) == 0 || m.allowed[method]
+}
+
+// Check returns an error if the JSON-RPC request with the given body, sent by the given client IP,
+// must be rejected. Each call of a batch request counts towards the rate limit of the client.
+// Requests that can't be decoded are left to the server to handle.
+func (m *Middleware) Check(ip string, body []byte) *Error {
+	calls, batch := decodeCalls(body)
+
+	if batch && m.maxBatchSize > 0 && len(calls) > m.maxBatchSize {
+		return m.reject(ip, &Error{
+			Code:    errCodeInvalidRequest,
+			Message: fmt.Sprintf("batch of %d calls exceeds the limit of %d calls", len(calls), m.maxBatchSize),
+			status:  http.StatusOK,
+			reason:  reasonBatchSize,
+		})
+	}
+
+	for _, c := range calls {
+		if !m.MethodAllowed(c.Method) {
+			return m.reject(ip, &Error{
+				Code:    errCodeMethodNotFound,
+				Message: fmt.Sprintf("the method %s does not exist/is not available", c.Method),
+				status:  http.StatusOK,
+				reason:  reasonMethod,
+			})
+		}
+	}
+
+	n := len(calls)
+	if n == 0 {
+		n = 1
+	}
+
+	// a batch with more calls than the burst size could never be admitted by the rate limiter
+	if m.limiter != nil && float64(n) > m.limiter.burst {
+		return m.reject(ip, &Error{
+			Code:    errCodeInvalidRequest,
+			Message: fmt.Sprintf("batch of %d calls exceeds the rate burst of %d calls", n, int(m.limiter.burst)),
+			status:  http.StatusOK,
+			reason:  reasonBatchSize,
+		})
+	}
+
+	if m.limiter != nil && !m.limiter.allowN(ip, time.Now(), n) {
+		return m.reject(ip, &Error{
+			Code:    errCodeLimitExceeded,
+			Message: "rate limit exceeded",
+			status:  http.StatusTooManyRequests,
+			reason:  reasonRateLimit,
+		})
+	}
+
+	return nil
+}
+
+// Handler wraps the given JSON-RPC HTTP handler with the checks of the requests and the limit on
+// the size of the responses.
+func (m *Middleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		_ = r.Body.Close()
+
+		if rpcErr := m.Check(ClientIP(r), body); rpcErr != nil {
+			writeError(w, requestID(body), rpcErr)
+			return
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		if m.maxResponseSize == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		lw := &limitedResponseWriter{ResponseWriter: w, limit: m.maxResponseSize, status: http.StatusOK}
+		next.ServeHTTP(lw, r)
+
+		if lw.exceeded {
+			writeError(w, requestID(body), m.reject(ClientIP(r), &Error{
+				Code:    errCodeResponseTooLarge,
+				Message: fmt.Sprintf("response exceeds the limit of %d bytes", m.maxResponseSize),
+				status:  http.StatusOK,
+				reason:  reasonResponseSize,
+			}))
+			return
+		}
+
+		w.WriteHeader(lw.status)
+		_, _ = w.Write(lw.buf.Bytes())
+	})
+}
+
+// reject logs and counts the rejection of a request.
+func (m *Middleware) reject(ip string, err *Error) *Error {
+	m.logger.Debug("JSON-RPC request rejected", "ip", ip, "reason", err.reason, "error", err.Message)
+	telemetry.IncrCounter(1, "json_rpc", "rejected", err.reason)
+	return err
+}
+
+// ClientIP returns the IP of the client that sent the given request. The X-Forwarded-For header is
+// only trusted for the requests sent from the loopback interface, eg: the requests forwarded by the
+// WebSocket server or by a local reverse proxy. Only its rightmost entry, which is appended by the
+// trusted proxy, is used, as the previous ones can be set by the client itself.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		entries := strings.Split(forwarded, ",")
+		if last := strings.TrimSpace(entries[len(entries)-1]); last != "" {
+			return last
+		}
+	}
+	return host
+}
+
+// decodeCalls decodes the calls of a single or batch JSON-RPC request. It returns true if the
+// request is a batch one.
+func decodeCalls(body []byte) ([]call, bool) {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var calls []call
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, true
+		}
+		return calls, true
+	}
+
+	var c call
+	if err := json.Unmarshal(body, &c); err != nil {
+		return nil, false
+	}
+	return []call{c}, false
+}
+
+// requestID returns the id of a single JSON-RPC request, or null for batch requests.
+func requestID(body []byte) json.RawMessage {
+	calls, batch := decodeCalls(body)
+	if batch || len(calls) != 1 || len(calls[0].ID) == 0 {
+		return json.RawMessage("null")
+	}
+	return calls[0].ID
+}
+
+// writeError writes the JSON-RPC response of a rejected request.
+func writeError(w http.ResponseWriter, id json.RawMessage, err *Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Version: "2.0", ID: id, Error: err})
+}
+
+// limitedResponseWriter buffers the response up to the size limit. The buffered response is
+// discarded once the limit is exceeded.
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	buf      bytes.Buffer
+	limit    int
+	status   int
+	exceeded bool
+}
+
+// WriteHeader records the status code, which is written along with the buffered response.
+func (w *limitedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+// Write buffers the response data, unless the size limit is exceeded.
+func (w *limitedResponseWriter) Write(p []byte) (int, error) {
+	if w.exceeded {
+		return len(p), nil
+	}
+
+	if w.buf.Len()+len(p) > w.limit {
+		w.exceeded = true
+		w.buf.Reset()
+		return len(p), nil
+	}
+
+	return w.buf.Write(p)
+}
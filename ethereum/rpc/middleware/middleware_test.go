@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/ethermint/server/config"
+)
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		malleate func(cfg *config.JSONRPCConfig)
+		body     string
+		expCode  int
+	}{
+		{
+			"default config",
+			func(*config.JSONRPCConfig) {},
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`,
+			0,
+		},
+		{
+			"denied method",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.DeniedMethods = []string{"eth_getLogs"}
+			},
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`,
+			errCodeMethodNotFound,
+		},
+		{
+			"method not in the allow list",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.AllowedMethods = []string{"eth_blockNumber"}
+			},
+			`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`,
+			errCodeMethodNotFound,
+		},
+		{
+			"method in the allow list",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.AllowedMethods = []string{"eth_blockNumber"}
+			},
+			`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`,
+			0,
+		},
+		{
+			"denied method within a batch",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.DeniedMethods = []string{"eth_getLogs"}
+			},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"}]`,
+			errCodeMethodNotFound,
+		},
+		{
+			"batch too large",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.MaxBatchSize = 1
+			},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"}]`,
+			errCodeInvalidRequest,
+		},
+		{
+			"batch calls exceed the rate burst",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.RateLimit = 1
+				cfg.RateBurst = 1
+			},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"}]`,
+			errCodeInvalidRequest,
+		},
+		{
+			"batch calls within the rate burst",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.RateLimit = 1
+				cfg.RateBurst = 2
+			},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"}]`,
+			0,
+		},
+		{
+			"invalid request left to the server",
+			func(cfg *config.JSONRPCConfig) {},
+			`invalid`,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			cfg := config.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := New(log.NewNopLogger(), *cfg).Check("1.2.3.4", []byte(tc.body))
+			if tc.expCode == 0 {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				require.Equal(t, tc.expCode, err.Code)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2, 4)
+	now := time.Now()
+
+	require.True(t, limiter.allowN("a", now, 4))
+	require.False(t, limiter.allowN("a", now, 1))
+	// the buckets are independent for each client
+	require.True(t, limiter.allowN("b", now, 1))
+
+	// refilled at 2 calls per second
+	require.True(t, limiter.allowN("a", now.Add(time.Second), 2))
+	require.False(t, limiter.allowN("a", now.Add(time.Second), 1))
+
+	// up to the burst size
+	require.False(t, limiter.allowN("a", now.Add(time.Hour), 5))
+	require.True(t, limiter.allowN("a", now.Add(time.Hour), 4))
+
+	// the full buckets are removed
+	limiter.cleanup(now.Add(2 * time.Hour))
+	require.Empty(t, limiter.buckets)
+}
+
+func TestHandler(t *testing.T) {
+	response := `{"jsonrpc":"2.0","id":1,"result":"` + strings.Repeat("0", 100) + `"}`
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	})
+
+	serve := func(cfg *config.JSONRPCConfig) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`))
+		req.RemoteAddr = "1.2.3.4:1234"
+
+		rec := httptest.NewRecorder()
+		New(log.NewNopLogger(), *cfg).Handler(next).ServeHTTP(rec, req)
+		return rec
+	}
+
+	decodeError := func(rec *httptest.ResponseRecorder) *Error {
+		var res errorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "1", string(res.ID))
+		return res.Error
+	}
+
+	cfg := config.DefaultJSONRPCConfig()
+	rec := serve(cfg)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, response, rec.Body.String())
+
+	cfg.MaxResponseSize = 100
+	rec = serve(cfg)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, errCodeResponseTooLarge, decodeError(rec).Code)
+
+	cfg = config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"eth_blockNumber"}
+	rec = serve(cfg)
+	require.Equal(t, errCodeMethodNotFound, decodeError(rec).Code)
+
+	cfg = config.DefaultJSONRPCConfig()
+	cfg.RateLimit = 1
+	cfg.RateBurst = 1
+	mw := New(log.NewNopLogger(), *cfg)
+	handler := mw.Handler(next)
+	for _, tc := range []struct {
+		remoteAddr string
+		forwarded  string
+		expStatus  int
+	}{
+		{"1.2.3.4:1234", "", http.StatusOK},
+		{"1.2.3.4:1234", "", http.StatusTooManyRequests},
+		// the forwarded IP is only trusted from the loopback interface
+		{"5.6.7.8:1234", "1.2.3.4", http.StatusOK},
+		{"127.0.0.1:1234", "1.2.3.4", http.StatusTooManyRequests},
+		{"127.0.0.1:1234", "9.9.9.9", http.StatusOK},
+		// a client behind the proxy can't spoof its IP, as only the entry appended by the proxy is used
+		{"127.0.0.1:1234", "6.6.6.6, 9.9.9.9", http.StatusTooManyRequests},
+		{"127.0.0.1:1234", "9.9.9.9, 7.7.7.7", http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`))
+		req.RemoteAddr = tc.remoteAddr
+		if tc.forwarded != "" {
+			req.Header.Set("X-Forwarded-For", tc.forwarded)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, tc.expStatus, rec.Code, tc)
+	}
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+)
+
+// bucketsCleanupInterval is the interval at which the buckets of the idle clients are removed.
+const bucketsCleanupInterval = time.Minute
+
+// bucket is the token bucket of a single client.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket rate limiter, with a bucket for each client key. Each bucket is
+// refilled at the given rate, up to the burst size.
+type rateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+// newRateLimiter returns a rate limiter allowing rate calls per second, with bursts of up to burst
+// calls, for each client key.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allowN reports whether the client with the given key can perform n calls at the given time, and
+// takes the corresponding tokens from its bucket if so.
+func (l *rateLimiter) allowN(key string, now time.Time, n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastCleanup) > bucketsCleanupInterval {
+		l.cleanup(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = l.refill(b, now)
+	b.last = now
+
+	if b.tokens < float64(n) {
+		return false
+	}
+
+	b.tokens -= float64(n)
+	return true
+}
+
+// refill returns the tokens of the given bucket at the given time.
+func (l *rateLimiter) refill(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return b.tokens
+	}
+
+	tokens := b.tokens + elapsed*l.rate
+	if tokens > l.burst {
+		return l.burst
+	}
+	return tokens
+}
+
+// cleanup removes the buckets that are full, as they are equivalent to new ones.
+func (l *rateLimiter) cleanup(now time.Time) {
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastCleanup = now
+}
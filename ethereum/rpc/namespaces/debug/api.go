@@ -2,6 +2,7 @@ package debug
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tharsis/ethermint/ethereum/rpc/backend"
@@ -83,22 +85,55 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 		return nil, err
 	}
 
-	ethMessage, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
-	if !ok {
+	ethMessage, err := evmtypes.UnwrapEthereumMsg(&tx, hash)
+	if err != nil {
 		a.logger.Debug("invalid transaction type", "type", fmt.Sprintf("%T", tx))
-		return nil, fmt.Errorf("invalid transaction type %T", tx)
+		return nil, err
+	}
+
+	resBlock, err := a.backend.GetTendermintBlockByNumber(rpctypes.BlockNumber(transaction.Height))
+	if err != nil {
+		a.logger.Debug("block not found", "height", transaction.Height)
+		return nil, err
+	}
+
+	// the transactions included before the traced one in the same block
+	predecessors, feeGranters, failedTxs, err := a.blockEthMessages(transaction.Height, resBlock.Block.Txs[:transaction.Index])
+	if err != nil {
+		return nil, err
+	}
+	txIndex := len(predecessors) - len(failedTxs)
+
+	// along with the previous ones of the same cosmos transaction
+	for _, msg := range tx.GetMsgs() {
+		if msg == ethMessage {
+			break
+		}
+		predecessor, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		predecessors = append(predecessors, predecessor)
+		addFeeGranter(feeGranters, tx, predecessor)
+		txIndex++
 	}
 
 	traceTxRequest := evmtypes.QueryTraceTxRequest{
-		Msg:     ethMessage,
-		TxIndex: uint64(transaction.Index),
+		Msg:             ethMessage,
+		TxIndex:         uint64(txIndex),
+		Predecessors:    predecessors,
+		FeeGranters:     feeGranters,
+		FailedTxs:       failedTxs,
+		BlockNumber:     resBlock.Block.Height,
+		BlockTime:       resBlock.Block.Time,
+		ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
 	}
 
 	if config != nil {
 		traceTxRequest.TraceConfig = config
 	}
 
-	traceResult, err := a.queryClient.TraceTx(rpctypes.ContextWithHeight(transaction.Height), &traceTxRequest)
+	traceResult, err := a.queryClient.TraceTx(rpctypes.ContextWithHeight(parentHeight(transaction.Height)), &traceTxRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +149,55 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return decodedResult, nil
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs created
+// during the execution of EVM if the given call was added on top of the provided block
+// and returns them as a JSON object.
+func (a *API) TraceCall(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum, err := a.getBlockNumber(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:   bz,
+		GasCap: a.backend.RPCGasCap(),
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+		if config.StateOverrides != nil {
+			traceCallRequest.Overrides, err = json.Marshal(config.StateOverrides)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	traceResult, err := a.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNum.Int64()), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
@@ -127,87 +211,136 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.T
 		return nil, err
 	}
 
-	return a.traceBlock(height, config, resBlock.Block.Txs)
+	return a.traceBlock(height, config, resBlock.Block)
 }
 
 // traceBlock configures a new tracer according to the provided configuration, and
-// executes all the transactions contained within. The return value will be one item
-// per transaction, dependent on the requested tracer.
-func (a API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, txs types.Txs) ([]*evmtypes.TxTraceResult, error) {
-	txsLength := len(txs)
+// executes all the transactions contained within. The transactions are traced
+// sequentially, each one on the state left by the previous ones. The return value
+// will be one item per Ethereum transaction, dependent on the requested tracer.
+func (a API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *types.Block) ([]*evmtypes.TxTraceResult, error) {
+	msgs, feeGranters, failedTxs, err := a.blockEthMessages(int64(height), block.Txs)
+	if err != nil {
+		return nil, err
+	}
 
-	if txsLength == 0 {
+	if len(msgs) == len(failedTxs) {
 		// If there are no transactions return empty array
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
+	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
+		Txs:             msgs,
+		TraceConfig:     config,
+		FeeGranters:     feeGranters,
+		FailedTxs:       failedTxs,
+		BlockNumber:     block.Height,
+		BlockTime:       block.Time,
+		ProposerAddress: sdk.ConsAddress(block.ProposerAddress),
+	}
+
+	res, err := a.queryClient.TraceBlock(rpctypes.ContextWithHeight(parentHeight(int64(height))), traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var results []*evmtypes.TxTraceResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// blockEthMessages returns the Ethereum messages of the given transactions from the
+// block at the given height, along with the fee granters of the messages paying their
+// fees through a fee grant and the index of the cosmos transaction of the messages
+// whose delivery failed, both indexed by hash. The failed transactions are included,
+// as the fee deduction and the nonce increment of the AnteHandler are committed when
+// their messages fail or when a message of a multi-message transaction reverts.
+func (a API) blockEthMessages(height int64, txs types.Txs) ([]*evmtypes.MsgEthereumTx, map[string]string, map[string]uint64, error) {
+	feeGranters := make(map[string]string)
+	failedTxs := make(map[string]uint64)
+	if len(txs) == 0 {
+		return nil, feeGranters, failedTxs, nil
+	}
+
+	resBlockResult, err := a.clientCtx.Client.BlockResults(context.Background(), &height)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	var (
-		results = make([]*evmtypes.TxTraceResult, txsLength)
-		wg      = new(sync.WaitGroup)
-		jobs    = make(chan *evmtypes.TxTraceTask, txsLength)
+		msgs      []*evmtypes.MsgEthereumTx
+		txDecoder = a.clientCtx.TxConfig.TxDecoder()
 	)
 
-	threads := runtime.NumCPU()
-	if threads > txsLength {
-		threads = txsLength
-	}
-
-	ctxWithHeight := rpctypes.ContextWithHeight(int64(height))
-
-	wg.Add(threads)
-	for th := 0; th < threads; th++ {
-		go func() {
-			defer wg.Done()
-			txDecoder := a.clientCtx.TxConfig.TxDecoder()
-			// Fetch and execute the next transaction trace tasks
-			for task := range jobs {
-				tx, err := txDecoder(txs[task.Index])
-				if err != nil {
-					a.logger.Error("failed to decode transaction", "hash", txs[task.Index].Hash(), "error", err.Error())
-					continue
-				}
-
-				messages := tx.GetMsgs()
-				if len(messages) == 0 {
-					continue
-				}
-				ethMessage, ok := messages[0].(*evmtypes.MsgEthereumTx)
-				if !ok {
-					// Just considers Ethereum transactions
-					continue
-				}
-
-				traceTxRequest := &evmtypes.QueryTraceTxRequest{
-					Msg:         ethMessage,
-					TxIndex:     uint64(task.Index),
-					TraceConfig: config,
-				}
-
-				res, err := a.queryClient.TraceTx(ctxWithHeight, traceTxRequest)
-				if err != nil {
-					results[task.Index] = &evmtypes.TxTraceResult{Error: err.Error()}
-					continue
-				}
-				// Response format is unknown due to custom tracer config param
-				// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
-				var decodedResult interface{}
-				if err := json.Unmarshal(res.Data, &decodedResult); err != nil {
-					results[task.Index] = &evmtypes.TxTraceResult{Error: err.Error()}
-					continue
-				}
-				results[task.Index] = &evmtypes.TxTraceResult{Result: decodedResult}
+	for i, txBz := range txs {
+		if i >= len(resBlockResult.TxsResults) {
+			continue
+		}
+		failed := resBlockResult.TxsResults[i].Code != 0
+
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			a.logger.Debug("failed to decode transaction", "hash", txBz.Hash(), "error", err.Error())
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// Just considers Ethereum transactions
+				continue
+			}
+			msgs = append(msgs, ethMessage)
+			addFeeGranter(feeGranters, tx, ethMessage)
+			if failed {
+				failedTxs[ethMessage.Hash] = uint64(i)
 			}
-		}()
+		}
 	}
 
-	for i := range txs {
-		jobs <- &evmtypes.TxTraceTask{Index: i}
+	return msgs, feeGranters, failedTxs, nil
+}
+
+// addFeeGranter adds the fee granter of the given transaction, if any, as the fee
+// granter of its Ethereum message, as the AnteHandler deducts the fees of all the
+// messages of the transaction from the fee granter.
+func addFeeGranter(feeGranters map[string]string, tx sdk.Tx, msg *evmtypes.MsgEthereumTx) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() == nil {
+		return
 	}
 
-	close(jobs)
-	wg.Wait()
+	feeGranters[msg.Hash] = feeTx.FeeGranter().String()
+}
 
-	return results, nil
+// getBlockNumber returns the block number of the given block number or hash.
+func (a API) getBlockNumber(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
+	switch {
+	case blockNrOrHash.BlockHash == nil && blockNrOrHash.BlockNumber == nil:
+		return rpctypes.EthEarliestBlockNumber, fmt.Errorf("types BlockHash and BlockNumber cannot be both nil")
+	case blockNrOrHash.BlockHash != nil:
+		blockHeader, err := a.backend.HeaderByHash(*blockNrOrHash.BlockHash)
+		if err != nil {
+			return rpctypes.EthEarliestBlockNumber, err
+		}
+		return rpctypes.NewBlockNumber(blockHeader.Number), nil
+	default:
+		return *blockNrOrHash.BlockNumber, nil
+	}
+}
+
+// parentHeight returns the height of the state a block at the given height was
+// executed on, which is the state committed on the previous block.
+func parentHeight(height int64) int64 {
+	if height <= 1 {
+		return 1
+	}
+	return height - 1
 }
 
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
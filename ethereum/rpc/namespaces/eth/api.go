@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/core/vm"
 	"google.golang.org/grpc/codes"
@@ -14,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -31,6 +31,7 @@ import (
 
 	"github.com/tharsis/ethermint/crypto/hd"
 	"github.com/tharsis/ethermint/ethereum/rpc/backend"
+	"github.com/tharsis/ethermint/ethereum/rpc/proof"
 	rpctypes "github.com/tharsis/ethermint/ethereum/rpc/types"
 	ethermint "github.com/tharsis/ethermint/types"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
@@ -162,10 +163,32 @@ func (e *PublicAPI) Hashrate() hexutil.Uint64 {
 }
 
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
-func (e *PublicAPI) GasPrice() *hexutil.Big {
+func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 	e.logger.Debug("eth_gasPrice")
-	out := new(big.Int).SetUint64(e.backend.RPCGasCap())
-	return (*hexutil.Big)(out)
+	price, err := e.backend.SuggestGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(price), nil
+}
+
+// MaxPriorityFeePerGas returns a suggestion for a gas tip cap for dynamic fee transactions.
+func (e *PublicAPI) MaxPriorityFeePerGas() (*hexutil.Big, error) {
+	e.logger.Debug("eth_maxPriorityFeePerGas")
+	tipCap, err := e.backend.SuggestGasTipCap()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tipCap), nil
+}
+
+// FeeHistory returns the base fee per gas, the gas used ratio and the effective priority fee
+// percentiles of the requested range of blocks.
+func (e *PublicAPI) FeeHistory(
+	blockCount rpctypes.DecimalOrHex, lastBlock rpctypes.BlockNumber, rewardPercentiles []float64,
+) (*rpctypes.FeeHistoryResult, error) {
+	e.logger.Debug("eth_feeHistory", "block count", blockCount, "last block", lastBlock)
+	return e.backend.FeeHistory(uint64(blockCount), lastBlock, rewardPercentiles)
 }
 
 // Accounts returns the list of accounts available to this node.
@@ -267,8 +290,7 @@ func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Ui
 		return nil
 	}
 
-	n := hexutil.Uint(len(resBlock.Block.Txs))
-	return &n
+	return e.getBlockTransactionCount(resBlock.Block)
 }
 
 // GetBlockTransactionCountByNumber returns the number of transactions in the block identified by number.
@@ -285,7 +307,28 @@ func (e *PublicAPI) GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumb
 		return nil
 	}
 
-	n := hexutil.Uint(len(resBlock.Block.Txs))
+	return e.getBlockTransactionCount(resBlock.Block)
+}
+
+// getBlockTransactionCount returns the number of ethereum transactions executed in the block, as a
+// cosmos transaction can contain multiple ethereum transactions.
+func (e *PublicAPI) getBlockTransactionCount(block *tmtypes.Block) *hexutil.Uint {
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &block.Height)
+	if err != nil {
+		e.logger.Debug("failed to retrieve block results", "height", block.Height, "error", err.Error())
+		return nil
+	}
+
+	n := hexutil.Uint(0)
+	for _, txResult := range blockRes.TxsResults {
+		parsedTxs, err := rpctypes.ParseTxResult(txResult)
+		if err != nil {
+			e.logger.Debug("failed to parse tx result", "height", block.Height, "error", err.Error())
+			continue
+		}
+		n += hexutil.Uint(len(parsedTxs))
+	}
+
 	return &n
 }
 
@@ -434,14 +477,14 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 }
 
 // Call performs a raw contract call.
-func (e *PublicAPI) Call(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error) {
+func (e *PublicAPI) Call(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
 	blockNum, err := e.getBlockNumber(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.doCall(args, blockNum)
+	data, err := e.doCall(args, blockNum, overrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -452,13 +495,19 @@ func (e *PublicAPI) Call(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNum
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (e *PublicAPI) doCall(
-	args evmtypes.CallArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.CallArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
 	req := evmtypes.EthCallRequest{Args: bz, GasCap: e.backend.RPCGasCap()}
+	if overrides != nil {
+		req.Overrides, err = json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -479,9 +528,52 @@ func (e *PublicAPI) doCall(
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.CallArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.CallArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
+}
+
+// CreateAccessList returns the access list of the addresses and storage keys accessed by the given
+// call, along with the gas used by the call once the access list is applied. The call is executed
+// on the pending state if no block is provided.
+func (e *PublicAPI) CreateAccessList(
+	args evmtypes.CallArgs, blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String())
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.getBlockNumber(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.EthCallRequest{Args: bz, GasCap: e.backend.RPCGasCap()}
+
+	res, err := e.queryClient.CreateAccessList(rpctypes.ContextWithHeight(blockNum.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty access list is encoded as an empty array rather than null
+	accessList := ethtypes.AccessList{}
+	if len(res.AccessList) > 0 {
+		accessList = *res.AccessList.ToEthAccessList()
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: &accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
 }
 
 // GetBlockByHash returns the block identified by hash.
@@ -517,32 +609,7 @@ func (e *PublicAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexu
 		return nil, nil
 	}
 
-	i := int(idx)
-	if i >= len(resBlock.Block.Txs) {
-		e.logger.Debug("block txs index out of bound", "index", i)
-		return nil, nil
-	}
-
-	txBz := resBlock.Block.Txs[i]
-	tx, err := e.clientCtx.TxConfig.TxDecoder()(txBz)
-	if err != nil {
-		e.logger.Debug("decoding failed", "error", err.Error())
-		return nil, fmt.Errorf("failed to decode tx: %w", err)
-	}
-
-	msg, err := evmtypes.UnwrapEthereumMsg(&tx)
-	if err != nil {
-		e.logger.Debug("invalid tx", "error", err.Error())
-		return nil, err
-	}
-
-	return rpctypes.NewTransactionFromMsg(
-		msg,
-		hash,
-		uint64(resBlock.Block.Height),
-		uint64(idx),
-		e.chainIDEpoch,
-	)
+	return e.getTransactionByBlockAndIndex(resBlock.Block, idx)
 }
 
 // GetTransactionByBlockNumberAndIndex returns the transaction identified by number and index.
@@ -560,20 +627,42 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 		return nil, nil
 	}
 
-	i := int(idx)
-	if i >= len(resBlock.Block.Txs) {
-		e.logger.Debug("block txs index out of bound", "index", i)
+	return e.getTransactionByBlockAndIndex(resBlock.Block, idx)
+}
+
+// getTransactionByBlockAndIndex returns the ethereum transaction at the given index of the block,
+// which is the index among the ethereum transactions executed in the block.
+func (e *PublicAPI) getTransactionByBlockAndIndex(block *tmtypes.Block, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	res, err := e.backend.GetTxByTxIndex(block.Height, uint(idx))
+	if err != nil {
+		e.logger.Debug("tx not found", "height", block.Height, "index", idx, "error", err.Error())
+		return nil, nil
+	}
+
+	parsedTxs, err := rpctypes.ParseTxResult(&res.TxResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tx events: %w", err)
+	}
+
+	var parsedTx *rpctypes.ParsedTx
+	for i := range parsedTxs {
+		if parsedTxs[i].EthTxIndex == uint64(idx) {
+			parsedTx = &parsedTxs[i]
+			break
+		}
+	}
+	if parsedTx == nil {
+		e.logger.Debug("tx not found", "height", block.Height, "index", idx)
 		return nil, nil
 	}
 
-	txBz := resBlock.Block.Txs[i]
-	tx, err := e.clientCtx.TxConfig.TxDecoder()(txBz)
+	tx, err := e.clientCtx.TxConfig.TxDecoder()(res.Tx)
 	if err != nil {
 		e.logger.Debug("decoding failed", "error", err.Error())
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
 
-	msg, err := evmtypes.UnwrapEthereumMsg(&tx)
+	msg, err := evmtypes.UnwrapEthereumMsg(&tx, parsedTx.Hash)
 	if err != nil {
 		e.logger.Debug("invalid tx", "error", err.Error())
 		return nil, err
@@ -581,8 +670,8 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 
 	return rpctypes.NewTransactionFromMsg(
 		msg,
-		common.BytesToHash(resBlock.Block.Hash()),
-		uint64(resBlock.Block.Height),
+		common.BytesToHash(block.Hash()),
+		uint64(block.Height),
 		uint64(idx),
 		e.chainIDEpoch,
 	)
@@ -610,7 +699,17 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
 
-	msg, err := evmtypes.UnwrapEthereumMsg(&tx)
+	parsedTxs, err := rpctypes.ParseTxResult(&res.TxResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tx events: %w", err)
+	}
+
+	parsedTx := parsedTxs.GetTxByHash(hash)
+	if parsedTx == nil {
+		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hash.Hex())
+	}
+
+	msg, err := evmtypes.UnwrapEthereumMsg(&tx, hash)
 	if err != nil {
 		e.logger.Debug("invalid tx", "error", err.Error())
 		return nil, err
@@ -629,13 +728,16 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 		return nil, nil
 	}
 
-	for i := 0; i <= int(res.Index) && i < len(blockRes.TxsResults); i++ {
+	for i := 0; i < int(res.Index) && i < len(blockRes.TxsResults); i++ {
 		cumulativeGasUsed += uint64(blockRes.TxsResults[i].GasUsed)
 	}
 
-	// Get the transaction result from the log
+	// add the gas used by the previous ethereum transactions of the same cosmos transaction
+	cumulativeGasUsed += parsedTxs.AccumulativeGasUsed(parsedTx.MsgIndex)
+
+	// Get the transaction result from the events
 	var status hexutil.Uint
-	if strings.Contains(res.TxResult.GetLog(), evmtypes.AttributeKeyEthereumTxFailed) {
+	if parsedTx.Failed {
 		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
 	} else {
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
@@ -662,14 +764,14 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 		// They are stored in the chain database.
 		"transactionHash": hash,
 		"contractAddress": nil,
-		"gasUsed":         hexutil.Uint64(res.TxResult.GasUsed),
+		"gasUsed":         hexutil.Uint64(parsedTx.GasUsed),
 		"type":            hexutil.Uint(txData.TxType()),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
 		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
-		"transactionIndex": hexutil.Uint64(res.Index),
+		"transactionIndex": hexutil.Uint64(parsedTx.EthTxIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
@@ -700,24 +802,26 @@ func (e *PublicAPI) PendingTransactions() ([]*rpctypes.RPCTransaction, error) {
 
 	result := make([]*rpctypes.RPCTransaction, 0, len(txs))
 	for _, tx := range txs {
-		msg, err := evmtypes.UnwrapEthereumMsg(tx)
-		if err != nil {
-			// not valid ethereum tx
-			continue
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			rpctx, err := rpctypes.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				e.chainIDEpoch,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, rpctx)
 		}
-
-		rpctx, err := rpctypes.NewTransactionFromMsg(
-			msg,
-			common.Hash{},
-			uint64(0),
-			uint64(0),
-			e.chainIDEpoch,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		result = append(result, rpctx)
 	}
 
 	return result, nil
@@ -733,7 +837,10 @@ func (e *PublicAPI) GetUncleByBlockNumberAndIndex(number, idx hexutil.Uint) map[
 	return nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs. The proofs are hex encoded
+// ICS-23 commitment proofs, verifiable against the app hash of the header of the requested block,
+// which commits to the state left by the previous block. The returned account and storage values
+// are the ones from that state. The storage hash is the root of the evm module store.
 func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
 	e.logger.Debug("eth_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
 
@@ -741,9 +848,19 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 	if err != nil {
 		return nil, err
 	}
+
 	height := blockNum.Int64()
+	if height <= 0 {
+		// the proofs need the height of an actual block
+		currentBlock, err := e.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = int64(currentBlock)
+	}
 
-	ctx := rpctypes.ContextWithHeight(height)
+	// the proofs are queried on the IAVL version below the height, see QueryClient.GetProof
+	ctx := rpctypes.ContextWithHeight(height - 1)
 	clientCtx := e.clientCtx.WithHeight(height)
 
 	// query storage proofs
@@ -751,24 +868,43 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := e.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		valueBz, proofOps, err := e.queryClient.GetProof(clientCtx, evmtypes.StoreKey, proof.StorageKey(address, hexKey))
 		if err != nil {
 			return nil, err
 		}
 
-		// check for proof
-		var proofStr string
-		if proof != nil {
-			proofStr = proof.String()
+		storageProof, err := proof.EncodeProofOps(proofOps)
+		if err != nil {
+			return nil, err
 		}
 
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   key,
 			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: []string{proofStr},
+			Proof: storageProof,
+		}
+	}
+
+	// the root of the evm store is computed from any of its proofs
+	storageProof := []string{}
+	if len(storageProofs) > 0 {
+		storageProof = storageProofs[0].Proof
+	} else {
+		_, proofOps, err := e.queryClient.GetProof(clientCtx, evmtypes.StoreKey, proof.StorageKey(address, common.Hash{}))
+		if err != nil {
+			return nil, err
+		}
+
+		if storageProof, err = proof.EncodeProofOps(proofOps); err != nil {
+			return nil, err
 		}
 	}
 
+	storageHash, err := proof.StoreRoot(storageProof)
+	if err != nil {
+		return nil, err
+	}
+
 	// query EVM account
 	req := &evmtypes.QueryAccountRequest{
 		Address: address.String(),
@@ -780,16 +916,14 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 	}
 
 	// query account proofs
-	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes()))
-	_, proof, err := e.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	_, proofOps, err := e.queryClient.GetProof(clientCtx, authtypes.StoreKey, proof.AccountKey(address))
 	if err != nil {
 		return nil, err
 	}
 
-	// check for proof
-	var accProofStr string
-	if proof != nil {
-		accProofStr = proof.String()
+	accountProof, err := proof.EncodeProofOps(proofOps)
+	if err != nil {
+		return nil, err
 	}
 
 	balance, ok := sdk.NewIntFromString(res.Balance)
@@ -799,11 +933,11 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 
 	return &rpctypes.AccountResult{
 		Address:      address,
-		AccountProof: []string{accProofStr},
+		AccountProof: accountProof,
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Ethermint doesn't have a storage hash. TODO: implement?
+		StorageHash:  storageHash,
 		StorageProof: storageProofs,
 	}, nil
 }
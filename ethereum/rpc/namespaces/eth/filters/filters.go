@@ -2,7 +2,6 @@ package filters
 
 import (
 	"context"
-	"fmt"
 	"math/big"
 
 	"github.com/tharsis/ethermint/ethereum/rpc/types"
@@ -132,50 +131,25 @@ func (f *Filter) Logs(_ context.Context) ([]*ethtypes.Log, error) {
 		f.criteria.ToBlock = big.NewInt(head + maxToOverhang)
 	}
 
+	// the logs are read from the events of all the transactions of the blocks, as the logs emitted
+	// outside of the ethereum transactions, such as by the IBC callbacks of the precompiles, don't
+	// belong to an ethereum transaction of the block
 	for i := f.criteria.FromBlock.Int64(); i <= f.criteria.ToBlock.Int64(); i++ {
-		block, err := f.backend.GetBlockByNumber(types.BlockNumber(i), false)
+		header, err := f.backend.HeaderByNumber(types.BlockNumber(i))
 		if err != nil {
-			return logs, errors.Wrapf(err, "failed to fetch block by number %d", i)
+			return logs, errors.Wrapf(err, "failed to fetch header by number %d", i)
 		}
 
-		if block["transactions"] == nil {
-			continue
-		}
-
-		var txHashes []common.Hash
-
-		txs, ok := block["transactions"].([]interface{})
-		if !ok {
-			_, ok = block["transactions"].([]common.Hash)
-			if !ok {
-				f.logger.Error(
-					"reading transactions from block data failed",
-					"type", fmt.Sprintf("%T", block["transactions"]),
-				)
-			}
-
-			continue
-		}
-
-		if len(txs) == 0 {
+		if header == nil {
 			continue
 		}
 
-		for _, tx := range txs {
-			txHash, ok := tx.(common.Hash)
-			if !ok {
-				f.logger.Error(
-					"transactions list contains non-hash element",
-					"type", fmt.Sprintf("%T", tx),
-				)
-				continue
-			}
-
-			txHashes = append(txHashes, txHash)
+		blockLogs, err := f.blockLogs(header)
+		if err != nil {
+			return logs, err
 		}
 
-		logsMatched := f.checkMatches(txHashes)
-		logs = append(logs, logsMatched...)
+		logs = append(logs, blockLogs...)
 	}
 
 	return logs, nil
@@ -206,22 +180,3 @@ func (f *Filter) blockLogs(header *ethtypes.Header) ([]*ethtypes.Log, error) {
 
 	return logs, nil
 }
-
-// checkMatches checks if the logs from the a list of transactions transaction
-// contain any log events that  match the filter criteria. This function is
-// called when the bloom filter signals a potential match.
-func (f *Filter) checkMatches(transactions []common.Hash) []*ethtypes.Log {
-	unfiltered := []*ethtypes.Log{}
-	for _, tx := range transactions {
-		logs, err := f.backend.GetTransactionLogs(tx)
-		if err != nil {
-			// ignore error if transaction didn't set any logs (eg: when tx type is not
-			// MsgEthereumTx or MsgEthermint)
-			continue
-		}
-
-		unfiltered = append(unfiltered, logs...)
-	}
-
-	return FilterLogs(unfiltered, f.criteria.FromBlock, f.criteria.ToBlock, f.criteria.Addresses, f.criteria.Topics)
-}
@@ -1,51 +1,241 @@
 package txpool
 
 import (
+	"fmt"
+	"math/big"
+	"sort"
+
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/tharsis/ethermint/ethereum/rpc/backend"
 	"github.com/tharsis/ethermint/ethereum/rpc/types"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/tharsis/ethermint/issues/124
+// The pool content is built from the unconfirmed transactions of the Tendermint mempool. Only
+// `MsgEthereumTx` transactions are taken into account.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	chainID *big.Int
+	backend backend.Backend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, clientCtx client.Context, backend backend.Backend) *PublicAPI {
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		panic(err)
+	}
+
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		chainID: chainID,
+		backend: backend,
 	}
 }
 
+// poolTx is an ethereum transaction from the mempool along with its unpacked tx data.
+type poolTx struct {
+	msg  *evmtypes.MsgEthereumTx
+	data evmtypes.TxData
+}
+
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, queued, err := api.content()
+	if err != nil {
+		return nil, err
+	}
+
+	flatten := func(txs map[common.Address][]poolTx) (map[string]map[string]*types.RPCTransaction, error) {
+		result := make(map[string]map[string]*types.RPCTransaction, len(txs))
+		for sender, senderTxs := range txs {
+			dump := make(map[string]*types.RPCTransaction, len(senderTxs))
+			for _, tx := range senderTxs {
+				rpcTx, err := types.NewTransactionFromData(tx.data, sender, tx.msg.AsTransaction().Hash(), common.Hash{}, 0, 0)
+				if err != nil {
+					return nil, err
+				}
+				dump[fmt.Sprintf("%d", tx.data.GetNonce())] = rpcTx
+			}
+			result[sender.Hex()] = dump
+		}
+		return result, nil
+	}
+
+	pendingContent, err := flatten(pending)
+	if err != nil {
+		return nil, err
+	}
+	queuedContent, err := flatten(queued)
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		"pending": pendingContent,
+		"queued":  queuedContent,
 	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, queued, err := api.content()
+	if err != nil {
+		return nil, err
+	}
+
+	// Define a formatter to flatten a transaction into a string
+	format := func(tx evmtypes.TxData) string {
+		if to := tx.GetTo(); to != nil {
+			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.GetValue(), tx.GetGas(), tx.GetGasPrice())
+		}
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.GetValue(), tx.GetGas(), tx.GetGasPrice())
+	}
+
+	flatten := func(txs map[common.Address][]poolTx) map[string]map[string]string {
+		result := make(map[string]map[string]string, len(txs))
+		for sender, senderTxs := range txs {
+			dump := make(map[string]string, len(senderTxs))
+			for _, tx := range senderTxs {
+				dump[fmt.Sprintf("%d", tx.data.GetNonce())] = format(tx.data)
+			}
+			result[sender.Hex()] = dump
+		}
+		return result
+	}
+
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": flatten(pending),
+		"queued":  flatten(queued),
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.content()
+	if err != nil {
+		return nil, err
+	}
+
+	count := func(txs map[common.Address][]poolTx) (n int) {
+		for _, senderTxs := range txs {
+			n += len(senderTxs)
+		}
+		return n
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(count(pending)),
+		"queued":  hexutil.Uint(count(queued)),
+	}, nil
+}
+
+// content retrieves the ethereum transactions from the mempool and groups them by sender.
+// The transactions of each sender are split into pending and queued ones, see splitPoolTxs.
+func (api *PublicAPI) content() (pending, queued map[common.Address][]poolTx, err error) {
+	txs, err := api.backend.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bySender := make(map[common.Address][]poolTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(api.chainID)
+			if err != nil {
+				api.logger.Debug("failed to get tx sender", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			data, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				api.logger.Debug("failed to unpack tx data", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			bySender[sender] = append(bySender[sender], poolTx{msg: ethMsg, data: data})
+		}
 	}
+
+	pending = make(map[common.Address][]poolTx)
+	queued = make(map[common.Address][]poolTx)
+
+	for sender, senderTxs := range bySender {
+		accNonce, err := api.backend.GetTransactionCount(sender, types.EthLatestBlockNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		senderPending, senderQueued := splitPoolTxs(senderTxs, uint64(*accNonce))
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// splitPoolTxs sorts the transactions of a sender by nonce and splits them into the pending ones,
+// which can be executed next from the account nonce without any gaps, and the queued ones, after
+// a nonce gap. Of the transactions with the same nonce, only the replacement is kept: the one with
+// the highest gas price, or the last one in the mempool order for equal gas prices. Transactions
+// with a nonce lower than the account nonce are stale and dropped.
+func splitPoolTxs(txs []poolTx, accNonce uint64) (pending, queued []poolTx) {
+	byNonce := make(map[uint64]poolTx, len(txs))
+	for _, tx := range txs {
+		nonce := tx.data.GetNonce()
+		if nonce < accNonce {
+			continue
+		}
+		if current, found := byNonce[nonce]; found && tx.data.GetGasPrice().Cmp(current.data.GetGasPrice()) < 0 {
+			continue
+		}
+		byNonce[nonce] = tx
+	}
+
+	sorted := make([]poolTx, 0, len(byNonce))
+	for _, tx := range byNonce {
+		sorted = append(sorted, tx)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].data.GetNonce() < sorted[j].data.GetNonce()
+	})
+
+	next := accNonce
+	for i, tx := range sorted {
+		if tx.data.GetNonce() > next {
+			// nonce gap, the remaining txs can't be executed yet
+			return pending, sorted[i:]
+		}
+		pending = append(pending, tx)
+		next++
+	}
+
+	return pending, nil
 }
@@ -0,0 +1,123 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func newPoolTx(nonce uint64, gasPrice int64) poolTx {
+	to := common.BigToAddress(big.NewInt(1))
+	msg := evmtypes.NewTx(big.NewInt(9000), nonce, &to, nil, 21000, big.NewInt(gasPrice), nil, nil)
+
+	data, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		panic(err)
+	}
+
+	return poolTx{msg: msg, data: data}
+}
+
+func TestSplitPoolTxs(t *testing.T) {
+	// nonce and gas price of the transactions, in the mempool order
+	type txSpec struct {
+		nonce    uint64
+		gasPrice int64
+	}
+
+	testCases := []struct {
+		msg        string
+		txs        []txSpec
+		accNonce   uint64
+		expPending []txSpec
+		expQueued  []txSpec
+	}{
+		{"no txs", nil, 0, nil, nil},
+		{
+			"sequential nonces",
+			[]txSpec{{2, 1}, {0, 1}, {1, 1}},
+			0,
+			[]txSpec{{0, 1}, {1, 1}, {2, 1}},
+			nil,
+		},
+		{
+			"nonce gap",
+			[]txSpec{{0, 1}, {1, 1}, {3, 1}, {4, 1}},
+			0,
+			[]txSpec{{0, 1}, {1, 1}},
+			[]txSpec{{3, 1}, {4, 1}},
+		},
+		{
+			"gap from the account nonce",
+			[]txSpec{{6, 1}, {7, 1}},
+			5,
+			nil,
+			[]txSpec{{6, 1}, {7, 1}},
+		},
+		{
+			"stale nonces",
+			[]txSpec{{3, 1}, {4, 1}, {5, 1}, {6, 1}},
+			5,
+			[]txSpec{{5, 1}, {6, 1}},
+			nil,
+		},
+		{
+			"replacement with a higher gas price",
+			[]txSpec{{0, 1}, {1, 1}, {1, 2}},
+			0,
+			[]txSpec{{0, 1}, {1, 2}},
+			nil,
+		},
+		{
+			"replacement with a lower gas price",
+			[]txSpec{{0, 2}, {0, 1}},
+			0,
+			[]txSpec{{0, 2}},
+			nil,
+		},
+		{
+			"queued replacement",
+			[]txSpec{{2, 1}, {2, 3}, {0, 1}},
+			0,
+			[]txSpec{{0, 1}},
+			[]txSpec{{2, 3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			txs := make([]poolTx, len(tc.txs))
+			for i, tx := range tc.txs {
+				txs[i] = newPoolTx(tx.nonce, tx.gasPrice)
+			}
+
+			specs := func(txs []poolTx) []txSpec {
+				var res []txSpec
+				for _, tx := range txs {
+					res = append(res, txSpec{tx.data.GetNonce(), tx.data.GetGasPrice().Int64()})
+				}
+				return res
+			}
+
+			pending, queued := splitPoolTxs(txs, tc.accNonce)
+			require.Equal(t, tc.expPending, specs(pending))
+			require.Equal(t, tc.expQueued, specs(queued))
+		})
+	}
+}
+
+func TestSplitPoolTxsEqualGasPrice(t *testing.T) {
+	// the last transaction in the mempool order replaces the previous one
+	first, last := newPoolTx(0, 1), newPoolTx(0, 1)
+	last.msg.Hash = "0x01"
+
+	pending, queued := splitPoolTxs([]poolTx{first, last}, 0)
+	require.Len(t, pending, 1)
+	require.Equal(t, "0x01", pending[0].msg.Hash)
+	require.Empty(t, queued)
+}
@@ -0,0 +1,227 @@
+// Package proof encodes the merkle proofs of the Ethermint state returned by the `eth_getProof`
+// JSON-RPC method, and verifies them against the app hash of a block header.
+//
+// The account proof and each storage proof are lists of hex encoded ICS-23 commitment proofs,
+// ordered from the module store up to the app hash:
+//
+// * the first proof is the IAVL proof of the key within the store of the module (`acc` for the
+// accounts and `evm` for the contract storage).
+// * the second proof is the proof of the module store root within the multistore, which root is
+// the app hash.
+//
+// As the app hash of a block header commits to the state left by the previous block, the proofs
+// returned for a block are verified against the app hash of the header of that same block.
+// NOTE: the account balance is stored on the bank module, and it's not covered by the proofs.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/tharsis/ethermint/ethereum/rpc/types"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// emptyCodeHash is the code hash of the accounts without code.
+var emptyCodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+
+// cdc decodes the accounts stored on the auth module store.
+var cdc = newAccountCodec()
+
+func newAccountCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	ethermint.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// EncodeProofOps returns the hex encoded ICS-23 commitment proofs of the given proof operations,
+// as returned by an ABCI store query.
+func EncodeProofOps(ops *crypto.ProofOps) ([]string, error) {
+	if ops == nil || len(ops.Ops) == 0 {
+		return nil, errors.New("empty proof")
+	}
+
+	proof := make([]string, len(ops.Ops))
+	for i, op := range ops.Ops {
+		if op.Type != storetypes.ProofOpIAVLCommitment && op.Type != storetypes.ProofOpSimpleMerkleCommitment {
+			return nil, fmt.Errorf("unsupported proof op type %s at index %d", op.Type, i)
+		}
+		proof[i] = hexutil.Encode(op.Data)
+	}
+
+	return proof, nil
+}
+
+// DecodeProof decodes the hex encoded ICS-23 commitment proofs into a merkle proof.
+func DecodeProof(proof []string) (commitmenttypes.MerkleProof, error) {
+	if len(proof) == 0 {
+		return commitmenttypes.MerkleProof{}, errors.New("empty proof")
+	}
+
+	proofs := make([]*ics23.CommitmentProof, len(proof))
+	for i, p := range proof {
+		bz, err := hexutil.Decode(p)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, errors.Wrapf(err, "invalid proof encoding at index %d", i)
+		}
+
+		var cp ics23.CommitmentProof
+		if err := cp.Unmarshal(bz); err != nil || cp.Proof == nil {
+			return commitmenttypes.MerkleProof{}, fmt.Errorf("invalid commitment proof at index %d", i)
+		}
+		proofs[i] = &cp
+	}
+
+	return commitmenttypes.MerkleProof{Proofs: proofs}, nil
+}
+
+// StoreRoot returns the root hash of the module store computed from the given proof.
+func StoreRoot(proof []string) (common.Hash, error) {
+	mp, err := DecodeProof(proof)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	root, err := mp.Proofs[0].Calculate()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(root), nil
+}
+
+// AccountKey returns the key under which the given account is stored on the auth module store.
+func AccountKey(address common.Address) []byte {
+	return authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes()))
+}
+
+// StorageKey returns the key under which the given storage slot of an account is stored on the
+// evm module store.
+func StorageKey(address common.Address, key common.Hash) []byte {
+	return evmtypes.StateKey(address, evmtypes.KeyAddressStorage(address, key).Bytes())
+}
+
+// VerifyAccountResult verifies the account proof and the storage proofs of the given
+// `eth_getProof` result against the app hash.
+func VerifyAccountResult(appHash []byte, res *rpctypes.AccountResult) error {
+	if res == nil {
+		return errors.New("empty account result")
+	}
+
+	if err := VerifyAccountProof(appHash, res.Address, uint64(res.Nonce), res.CodeHash, res.AccountProof); err != nil {
+		return errors.Wrap(err, "invalid account proof")
+	}
+
+	for _, storage := range res.StorageProof {
+		if err := VerifyStorageProof(appHash, res.Address, res.StorageHash, storage); err != nil {
+			return errors.Wrapf(err, "invalid storage proof for key %s", storage.Key)
+		}
+	}
+
+	return nil
+}
+
+// VerifyAccountProof verifies that the account stored on the auth module store under the given
+// address has the given nonce and code hash. Accounts that don't exist are proven to be absent
+// from the store, with a zero nonce and an empty code hash.
+func VerifyAccountProof(appHash []byte, address common.Address, nonce uint64, codeHash common.Hash, proof []string) error {
+	mp, err := DecodeProof(proof)
+	if err != nil {
+		return err
+	}
+
+	root := commitmenttypes.NewMerkleRoot(appHash)
+	path := merklePath(authtypes.StoreKey, AccountKey(address))
+	specs := commitmenttypes.GetSDKSpecs()
+
+	exist := mp.Proofs[0].GetExist()
+	if exist == nil {
+		if nonce != 0 || codeHash != emptyCodeHash {
+			return errors.New("account doesn't exist")
+		}
+		return mp.VerifyNonMembership(specs, root, path)
+	}
+
+	if err := mp.VerifyMembership(specs, root, path, exist.Value); err != nil {
+		return err
+	}
+
+	var account authtypes.AccountI
+	if err := cdc.UnmarshalInterface(exist.Value, &account); err != nil {
+		return errors.Wrap(err, "failed to decode account")
+	}
+
+	if account.GetSequence() != nonce {
+		return fmt.Errorf("nonce mismatch, expected %d, got %d", account.GetSequence(), nonce)
+	}
+
+	expCodeHash := emptyCodeHash
+	if ethAccount, ok := account.(*ethermint.EthAccount); ok {
+		expCodeHash = ethAccount.GetCodeHash()
+	}
+
+	if expCodeHash != codeHash {
+		return fmt.Errorf("code hash mismatch, expected %s, got %s", expCodeHash, codeHash)
+	}
+
+	return nil
+}
+
+// VerifyStorageProof verifies that the given storage slot of an account has the given value on the
+// evm module store, which root is the storage hash. Slots with a zero value are proven to be absent
+// from the store.
+func VerifyStorageProof(appHash []byte, address common.Address, storageHash common.Hash, res rpctypes.StorageResult) error {
+	mp, err := DecodeProof(res.Proof)
+	if err != nil {
+		return err
+	}
+
+	storeRoot, err := mp.Proofs[0].Calculate()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(storeRoot, storageHash.Bytes()) {
+		return fmt.Errorf("storage hash mismatch, expected %s, got %s", common.BytesToHash(storeRoot), storageHash)
+	}
+
+	root := commitmenttypes.NewMerkleRoot(appHash)
+	path := merklePath(evmtypes.StoreKey, StorageKey(address, common.HexToHash(res.Key)))
+	specs := commitmenttypes.GetSDKSpecs()
+
+	value := new(big.Int)
+	if res.Value != nil {
+		value = res.Value.ToInt()
+	}
+
+	if value.Sign() == 0 {
+		return mp.VerifyNonMembership(specs, root, path)
+	}
+
+	return mp.VerifyMembership(specs, root, path, common.BigToHash(value).Bytes())
+}
+
+// merklePath returns the path of the given key within the store of a module. The key is escaped
+// as the merkle path keys are unescaped when verifying the proofs.
+func merklePath(storeKey string, key []byte) commitmenttypes.MerklePath {
+	return commitmenttypes.NewMerklePath(storeKey, url.PathEscape(string(key)))
+}
@@ -0,0 +1,242 @@
+package proof
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	rpctypes "github.com/tharsis/ethermint/ethereum/rpc/types"
+	"github.com/tharsis/ethermint/tests"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// testState is a multistore committing the auth and evm stores of a single contract.
+type testState struct {
+	appHash  []byte
+	store    *rootmulti.Store
+	version  int64
+	contract common.Address
+	codeHash common.Hash
+	slot     common.Hash
+	value    common.Hash
+}
+
+func setupState(t *testing.T) testState {
+	db := dbm.NewMemDB()
+	store := rootmulti.NewStore(db)
+
+	accKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	evmKey := sdk.NewKVStoreKey(evmtypes.StoreKey)
+	for _, key := range []*sdk.KVStoreKey{accKey, bankKey, evmKey} {
+		store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	contract := tests.GenerateAddress()
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+	account := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(contract.Bytes(), nil, 3, 7),
+		CodeHash:    codeHash.Hex(),
+	}
+	bz, err := cdc.MarshalInterface(account)
+	require.NoError(t, err)
+	store.GetKVStore(accKey).Set(AccountKey(contract), bz)
+
+	slot := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(100))
+	store.GetKVStore(evmKey).Set(StorageKey(contract, slot), value.Bytes())
+	store.GetKVStore(bankKey).Set([]byte("balance"), []byte("1000"))
+
+	commitID := store.Commit()
+
+	return testState{
+		appHash:  commitID.Hash,
+		store:    store,
+		version:  commitID.Version,
+		contract: contract,
+		codeHash: codeHash,
+		slot:     slot,
+		value:    value,
+	}
+}
+
+func (s testState) queryProof(t *testing.T, storeKey string, key []byte) ([]byte, []string) {
+	res := s.store.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeKey),
+		Data:   key,
+		Height: s.version,
+		Prove:  true,
+	})
+	require.Zero(t, res.Code, res.Log)
+
+	proof, err := EncodeProofOps(res.ProofOps)
+	require.NoError(t, err)
+	return res.Value, proof
+}
+
+func (s testState) accountResult(t *testing.T, address common.Address, slots ...common.Hash) *rpctypes.AccountResult {
+	_, accountProof := s.queryProof(t, authtypes.StoreKey, AccountKey(address))
+
+	storageProofs := make([]rpctypes.StorageResult, len(slots))
+	for i, slot := range slots {
+		value, storageProof := s.queryProof(t, evmtypes.StoreKey, StorageKey(address, slot))
+		storageProofs[i] = rpctypes.StorageResult{
+			Key:   slot.Hex(),
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(value)),
+			Proof: storageProof,
+		}
+	}
+
+	_, storeProof := s.queryProof(t, evmtypes.StoreKey, StorageKey(address, common.Hash{}))
+	storageHash, err := StoreRoot(storeProof)
+	require.NoError(t, err)
+
+	return &rpctypes.AccountResult{
+		Address:      address,
+		AccountProof: accountProof,
+		CodeHash:     emptyCodeHash,
+		StorageHash:  storageHash,
+		StorageProof: storageProofs,
+	}
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	state := setupState(t)
+
+	var (
+		res     *rpctypes.AccountResult
+		appHash []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"contract with storage",
+			func() {
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+			},
+			true,
+		},
+		{
+			"unset storage slot",
+			func() {
+				res = state.accountResult(t, state.contract, common.BigToHash(big.NewInt(2)))
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+			},
+			true,
+		},
+		{
+			"account not found",
+			func() {
+				res = state.accountResult(t, tests.GenerateAddress(), state.slot)
+			},
+			true,
+		},
+		{
+			"nonce mismatch",
+			func() {
+				res.Nonce = 8
+				res.CodeHash = state.codeHash
+			},
+			false,
+		},
+		{
+			"code hash mismatch",
+			func() {
+				res.Nonce = 7
+			},
+			false,
+		},
+		{
+			"nonce of an account not found",
+			func() {
+				res = state.accountResult(t, tests.GenerateAddress())
+				res.Nonce = 1
+			},
+			false,
+		},
+		{
+			"storage value mismatch",
+			func() {
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(101))
+			},
+			false,
+		},
+		{
+			"storage hash mismatch",
+			func() {
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+				res.StorageHash = common.BytesToHash([]byte("root"))
+			},
+			false,
+		},
+		{
+			"proof of another storage slot",
+			func() {
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+				res.StorageProof[0].Key = common.BigToHash(big.NewInt(2)).Hex()
+			},
+			false,
+		},
+		{
+			"invalid proof encoding",
+			func() {
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+				res.AccountProof = []string{"proof"}
+			},
+			false,
+		},
+		{
+			"app hash mismatch",
+			func() {
+				res.Nonce = 7
+				res.CodeHash = state.codeHash
+				appHash = crypto.Keccak256([]byte("app hash"))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			res = state.accountResult(t, state.contract, state.slot)
+			appHash = state.appHash
+
+			tc.malleate()
+
+			err := VerifyAccountResult(appHash, res)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// ParsedTx is the Ethereum transaction of a message of a Cosmos transaction, as parsed from the
+// events of the transaction result.
+type ParsedTx struct {
+	// MsgIndex is the index of the message within the Cosmos transaction
+	MsgIndex int
+	// Hash is the Ethereum transaction hash
+	Hash common.Hash
+	// EthTxIndex is the index of the Ethereum transaction within the block
+	EthTxIndex uint64
+	// GasUsed is the gas used by the Ethereum transaction
+	GasUsed uint64
+	// Failed is true if the Ethereum transaction was reverted by the EVM
+	Failed bool
+}
+
+// ParsedTxs are the Ethereum transactions of a Cosmos transaction, in message order.
+type ParsedTxs []ParsedTx
+
+// ParseTxResult parses the Ethereum transactions from the events of the result of a Cosmos
+// transaction. A failed Cosmos transaction doesn't execute any Ethereum transaction.
+func ParseTxResult(result *abci.ResponseDeliverTx) (ParsedTxs, error) {
+	txs := ParsedTxs{}
+	if result.Code != abci.CodeTypeOK {
+		return txs, nil
+	}
+
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeEthereumTx {
+			continue
+		}
+
+		tx := ParsedTx{MsgIndex: len(txs)}
+		for _, attr := range event.Attributes {
+			var err error
+			switch string(attr.Key) {
+			case evmtypes.AttributeKeyEthereumTxHash:
+				tx.Hash = common.HexToHash(string(attr.Value))
+			case evmtypes.AttributeKeyTxIndex:
+				tx.EthTxIndex, err = strconv.ParseUint(string(attr.Value), 10, 64)
+			case evmtypes.AttributeKeyTxGasUsed:
+				tx.GasUsed, err = strconv.ParseUint(string(attr.Value), 10, 64)
+			case evmtypes.AttributeKeyEthereumTxFailed:
+				tx.Failed = true
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s attribute of message %d: %w", attr.Key, tx.MsgIndex, err)
+			}
+		}
+
+		if tx.Hash == (common.Hash{}) {
+			return nil, fmt.Errorf("missing %s attribute of message %d", evmtypes.AttributeKeyEthereumTxHash, tx.MsgIndex)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// GetTxByHash returns the Ethereum transaction with the given hash, or nil if not found.
+func (p ParsedTxs) GetTxByHash(hash common.Hash) *ParsedTx {
+	for i := range p {
+		if p[i].Hash == hash {
+			return &p[i]
+		}
+	}
+
+	return nil
+}
+
+// AccumulativeGasUsed returns the gas used by the Ethereum transactions of the Cosmos
+// transaction up to the message at the given index, included.
+func (p ParsedTxs) AccumulativeGasUsed(msgIndex int) uint64 {
+	var gasUsed uint64
+	for i := 0; i <= msgIndex && i < len(p); i++ {
+		gasUsed += p[i].GasUsed
+	}
+
+	return gasUsed
+}
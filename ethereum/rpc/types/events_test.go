@@ -0,0 +1,104 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func ethereumTxEvent(hash common.Hash, txIndex, gasUsed string, failed bool) abci.Event {
+	attrs := []abci.EventAttribute{
+		{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(hash.Hex())},
+		{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte(txIndex)},
+		{Key: []byte(evmtypes.AttributeKeyTxGasUsed), Value: []byte(gasUsed)},
+	}
+	if failed {
+		attrs = append(attrs, abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyEthereumTxFailed), Value: []byte("reverted")})
+	}
+
+	return abci.Event{Type: evmtypes.EventTypeEthereumTx, Attributes: attrs}
+}
+
+func TestParseTxResult(t *testing.T) {
+	hash1 := common.BigToHash(common.Big1)
+	hash2 := common.BigToHash(common.Big2)
+
+	testCases := []struct {
+		msg     string
+		result  abci.ResponseDeliverTx
+		expTxs  ParsedTxs
+		expPass bool
+	}{
+		{
+			"multiple ethereum txs",
+			abci.ResponseDeliverTx{
+				Events: []abci.Event{
+					{Type: "message"},
+					ethereumTxEvent(hash1, "3", "21000", false),
+					{Type: evmtypes.EventTypeTxLog},
+					ethereumTxEvent(hash2, "4", "30000", true),
+				},
+			},
+			ParsedTxs{
+				{MsgIndex: 0, Hash: hash1, EthTxIndex: 3, GasUsed: 21000},
+				{MsgIndex: 1, Hash: hash2, EthTxIndex: 4, GasUsed: 30000, Failed: true},
+			},
+			true,
+		},
+		{
+			"failed cosmos tx",
+			abci.ResponseDeliverTx{
+				Code:   1,
+				Events: []abci.Event{ethereumTxEvent(hash1, "0", "21000", false)},
+			},
+			ParsedTxs{},
+			true,
+		},
+		{
+			"invalid tx index",
+			abci.ResponseDeliverTx{
+				Events: []abci.Event{ethereumTxEvent(hash1, "-1", "21000", false)},
+			},
+			nil,
+			false,
+		},
+		{
+			"missing hash",
+			abci.ResponseDeliverTx{
+				Events: []abci.Event{{Type: evmtypes.EventTypeEthereumTx}},
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		txs, err := ParseTxResult(&tc.result)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+			require.Equal(t, tc.expTxs, txs, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestParsedTxs(t *testing.T) {
+	hash1 := common.BigToHash(common.Big1)
+	hash2 := common.BigToHash(common.Big2)
+
+	txs := ParsedTxs{
+		{MsgIndex: 0, Hash: hash1, EthTxIndex: 0, GasUsed: 21000},
+		{MsgIndex: 1, Hash: hash2, EthTxIndex: 1, GasUsed: 30000},
+	}
+
+	require.Equal(t, &txs[1], txs.GetTxByHash(hash2))
+	require.Nil(t, txs.GetTxByHash(common.BigToHash(common.Big3)))
+
+	require.Equal(t, uint64(21000), txs.AccumulativeGasUsed(0))
+	require.Equal(t, uint64(51000), txs.AccumulativeGasUsed(1))
+}
@@ -11,14 +11,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //  - Transaction simulation
 //  - EVM module queries
+//  - Fee market module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
+	FeeMarket feemarkettypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -26,6 +29,7 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 	return &QueryClient{
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
+		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
 	}
 }
 
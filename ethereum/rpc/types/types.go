@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -39,6 +41,8 @@ type RPCTransaction struct {
 	From             common.Address       `json:"from"`
 	Gas              hexutil.Uint64       `json:"gas"`
 	GasPrice         *hexutil.Big         `json:"gasPrice"`
+	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
+	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
 	Hash             common.Hash          `json:"hash"`
 	Input            hexutil.Bytes        `json:"input"`
 	Nonce            hexutil.Uint64       `json:"nonce"`
@@ -128,18 +132,52 @@ func (args *SendTxArgs) ToTransaction() *evmtypes.MsgEthereumTx {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// TraceCallConfig is the config for the debug_traceCall API. It holds one more
+// field to override the state for tracing.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride `json:"stateOverrides,omitempty"`
+}
+
+// FeeHistoryResult is the result of the eth_feeHistory rpc api.
+type FeeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// AccessListResult is the result of the eth_createAccessList rpc api.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// DecimalOrHex unmarshals a non-negative decimal or hex parameter into a uint64.
+// Copied from: https://github.com/ethereum/go-ethereum/blob/v1.10.8/rpc/types.go#L222
+type DecimalOrHex uint64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (dh *DecimalOrHex) UnmarshalJSON(data []byte) error {
+	input := strings.TrimSpace(string(data))
+	if len(input) >= 2 && input[0] == '"' && input[len(input)-1] == '"' {
+		input = input[1 : len(input)-1]
+	}
+
+	value, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		value, err = hexutil.DecodeUint64(input)
+	}
+	if err != nil {
+		return err
+	}
+	*dh = DecimalOrHex(value)
+	return nil
 }
@@ -19,18 +19,22 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// RawTxToEthTx returns a evm MsgEthereum transaction from raw tx bytes.
-func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) (*evmtypes.MsgEthereumTx, error) {
+// RawTxToEthTx returns the evm MsgEthereum transactions from raw tx bytes.
+func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEthereumTx, error) {
 	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	ethTx, ok := tx.(*evmtypes.MsgEthereumTx)
-	if !ok {
-		return nil, fmt.Errorf("invalid transaction type %T, expected %T", tx, evmtypes.MsgEthereumTx{})
+	ethTxs := make([]*evmtypes.MsgEthereumTx, len(tx.GetMsgs()))
+	for i, msg := range tx.GetMsgs() {
+		ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
+		}
+		ethTxs[i] = ethTx
 	}
-	return ethTx, nil
+	return ethTxs, nil
 }
 
 // NewTransaction returns a transaction that will serialize to the RPC
@@ -68,10 +72,17 @@ func NewTransaction(tx *ethtypes.Transaction, blockHash common.Hash, blockNumber
 		result.BlockNumber = (*hexutil.Big)(new(big.Int).SetUint64(blockNumber))
 		result.TransactionIndex = (*hexutil.Uint64)(&index)
 	}
-	if tx.Type() == ethtypes.AccessListTxType {
+	switch tx.Type() {
+	case ethtypes.AccessListTxType:
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
+	case ethtypes.DynamicFeeTxType:
+		al := tx.AccessList()
+		result.Accesses = &al
+		result.ChainID = (*hexutil.Big)(tx.ChainId())
+		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
+		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
 	}
 	return result
 }
@@ -109,28 +120,31 @@ func EthTransactionsFromTendermint(clientCtx client.Context, txs []tmtypes.Tx) (
 	gasUsed := big.NewInt(0)
 
 	for _, tx := range txs {
-		ethTx, err := RawTxToEthTx(clientCtx, tx)
+		ethTxs, err := RawTxToEthTx(clientCtx, tx)
 		if err != nil {
 			// continue to next transaction in case it's not a MsgEthereumTx
 			continue
 		}
 
-		data, err := evmtypes.UnpackTxData(ethTx.Data)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to unpack tx data: %w", err)
-		}
+		for _, ethTx := range ethTxs {
+			data, err := evmtypes.UnpackTxData(ethTx.Data)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to unpack tx data: %w", err)
+			}
 
-		// TODO: Remove gas usage calculation if saving gasUsed per block
-		gasUsed.Add(gasUsed, data.Fee())
-		transactionHashes = append(transactionHashes, common.BytesToHash(tx.Hash()))
+			// TODO: Remove gas usage calculation if saving gasUsed per block
+			gasUsed.Add(gasUsed, data.Fee())
+			transactionHashes = append(transactionHashes, ethTx.AsTransaction().Hash())
+		}
 	}
 
 	return transactionHashes, gasUsed, nil
 }
 
-// BlockMaxGasFromConsensusParams returns the gas limit for the latest block from the chain consensus params.
-func BlockMaxGasFromConsensusParams(ctx context.Context, clientCtx client.Context) (int64, error) {
-	resConsParams, err := clientCtx.Client.ConsensusParams(ctx, nil)
+// BlockMaxGasFromConsensusParams returns the gas limit for the block at the given height from the
+// chain consensus params.
+func BlockMaxGasFromConsensusParams(ctx context.Context, clientCtx client.Context, blockHeight int64) (int64, error) {
+	resConsParams, err := clientCtx.Client.ConsensusParams(ctx, &blockHeight)
 	if err != nil {
 		return int64(^uint32(0)), err
 	}
@@ -273,10 +287,17 @@ func NewTransactionFromData(
 		rpcTx.TransactionIndex = (*hexutil.Uint64)(&index)
 	}
 
-	if txData.TxType() == ethtypes.AccessListTxType {
+	switch txData.TxType() {
+	case ethtypes.AccessListTxType:
+		accesses := txData.GetAccessList()
+		rpcTx.Accesses = &accesses
+		rpcTx.ChainID = (*hexutil.Big)(txData.GetChainID())
+	case ethtypes.DynamicFeeTxType:
 		accesses := txData.GetAccessList()
 		rpcTx.Accesses = &accesses
 		rpcTx.ChainID = (*hexutil.Big)(txData.GetChainID())
+		rpcTx.GasFeeCap = (*hexutil.Big)(txData.GetGasFeeCap())
+		rpcTx.GasTipCap = (*hexutil.Big)(txData.GetGasTipCap())
 	}
 
 	return rpcTx, nil
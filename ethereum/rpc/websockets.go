@@ -23,6 +23,7 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/tharsis/ethermint/ethereum/rpc/middleware"
 	rpcfilters "github.com/tharsis/ethermint/ethereum/rpc/namespaces/eth/filters"
 	"github.com/tharsis/ethermint/ethereum/rpc/types"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
@@ -61,19 +62,23 @@ type ErrorMessageJSON struct {
 }
 
 type websocketsServer struct {
-	rpcAddr string // listen address of rest-server
-	wsAddr  string // listen address of ws server
-	api     *pubSubAPI
-	logger  log.Logger
+	rpcAddr    string // listen address of rest-server
+	wsAddr     string // listen address of ws server
+	api        *pubSubAPI
+	middleware *middleware.Middleware
+	logger     log.Logger
 }
 
-func NewWebsocketsServer(logger log.Logger, tmWSClient *rpcclient.WSClient, rpcAddr, wsAddr string) WebsocketsServer {
+// NewWebsocketsServer creates a new WebSocket server. The subscription requests are checked by the
+// given middleware, while the other requests are checked by the JSON-RPC server they're forwarded to.
+func NewWebsocketsServer(logger log.Logger, tmWSClient *rpcclient.WSClient, mw *middleware.Middleware, rpcAddr, wsAddr string) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
-		rpcAddr: rpcAddr,
-		wsAddr:  wsAddr,
-		api:     newPubSubAPI(logger, tmWSClient),
-		logger:  logger,
+		rpcAddr:    rpcAddr,
+		wsAddr:     wsAddr,
+		api:        newPubSubAPI(logger, tmWSClient),
+		middleware: mw,
+		logger:     logger,
 	}
 }
 
@@ -107,8 +112,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:      new(sync.Mutex),
+		conn:     conn,
+		clientIP: middleware.ClientIP(r),
 	})
 }
 
@@ -126,8 +132,9 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 }
 
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn     *websocket.Conn
+	mux      *sync.Mutex
+	clientIP string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -177,6 +184,13 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
+		if method == "eth_subscribe" || method == "eth_unsubscribe" {
+			if rpcErr := s.middleware.Check(wsConn.clientIP, mb); rpcErr != nil {
+				s.sendErrResponse(wsConn, rpcErr.Error())
+				continue
+			}
+		}
+
 		connID := msg["id"].(float64)
 		if method == "eth_subscribe" {
 			params := msg["params"].([]interface{})
@@ -242,6 +256,8 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// the request is checked by the JSON-RPC server for the WebSocket client
+	req.Header.Set("X-Forwarded-For", wsConn.clientIP)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -27,6 +27,31 @@ const (
 	DefaultEVMTracer = "json"
 
 	DefaultGasCap uint64 = 25000000
+
+	// DefaultGasPriceBlocks is the default number of recent blocks sampled by the gas price oracle
+	DefaultGasPriceBlocks = 20
+
+	// DefaultGasPricePercentile is the default percentile of the sampled gas prices suggested by the
+	// gas price oracle
+	DefaultGasPricePercentile = 60
+
+	// DefaultGasPriceDefault is the default gas price (in wei) suggested by the gas price oracle when
+	// there are no samples. It also acts as the lowest price that the oracle can suggest.
+	DefaultGasPriceDefault uint64 = 0
+
+	// DefaultMaxBatchSize is the default maximum number of calls in a JSON-RPC batch request
+	DefaultMaxBatchSize = 1000
+
+	// DefaultMaxResponseSize is the default maximum size (in bytes) of a JSON-RPC response
+	DefaultMaxResponseSize = 25 * 1024 * 1024
+
+	// DefaultRateLimit is the default number of JSON-RPC calls per second allowed for each client
+	// IP. Rate limiting is disabled by default.
+	DefaultRateLimit = 0
+
+	// DefaultRateBurst is the default maximum number of JSON-RPC calls that a client IP can perform
+	// at once when rate limiting is enabled
+	DefaultRateBurst = 100
 )
 
 var evmTracers = []string{DefaultEVMTracer, "markdown", "struct", "access_list"}
@@ -114,6 +139,26 @@ type JSONRPCConfig struct {
 	Enable bool `mapstructure:"enable"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
+	// GasPriceBlocks is the number of recent blocks sampled by the gas price oracle.
+	GasPriceBlocks int `mapstructure:"gas-price-blocks"`
+	// GasPricePercentile is the percentile of the sampled gas prices suggested by the gas price oracle.
+	GasPricePercentile int `mapstructure:"gas-price-percentile"`
+	// GasPriceDefault is the gas price (in wei) suggested when there are no samples. It is also the
+	// lowest price that the gas price oracle suggests.
+	GasPriceDefault uint64 `mapstructure:"gas-price-default"`
+	// AllowedMethods is the list of the JSON-RPC methods that can be called. All the methods of the
+	// enabled namespaces can be called if empty.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods is the list of the JSON-RPC methods that cannot be called.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// MaxBatchSize is the maximum number of calls in a batch request (0=unlimited).
+	MaxBatchSize int `mapstructure:"max-batch-size"`
+	// MaxResponseSize is the maximum size in bytes of a response (0=unlimited).
+	MaxResponseSize int `mapstructure:"max-response-size"`
+	// RateLimit is the number of calls per second allowed for each client IP (0=unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateBurst is the maximum number of calls that a client IP can perform at once.
+	RateBurst int `mapstructure:"rate-burst"`
 }
 
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
@@ -122,6 +167,41 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if c.GasPriceBlocks < 1 {
+		return fmt.Errorf("gas price oracle blocks must be positive, got %d", c.GasPriceBlocks)
+	}
+
+	if c.GasPricePercentile < 0 || c.GasPricePercentile > 100 {
+		return fmt.Errorf("gas price oracle percentile must be between 0 and 100, got %d", c.GasPricePercentile)
+	}
+
+	if c.MaxBatchSize < 0 {
+		return fmt.Errorf("max batch size cannot be negative, got %d", c.MaxBatchSize)
+	}
+
+	if c.MaxResponseSize < 0 {
+		return fmt.Errorf("max response size cannot be negative, got %d", c.MaxResponseSize)
+	}
+
+	if c.RateLimit < 0 {
+		return fmt.Errorf("rate limit cannot be negative, got %f", c.RateLimit)
+	}
+
+	if c.RateLimit > 0 && c.RateBurst < 1 {
+		return fmt.Errorf("rate burst must be positive when the rate limit is enabled, got %d", c.RateBurst)
+	}
+
+	denied := make(map[string]bool)
+	for _, method := range c.DeniedMethods {
+		denied[method] = true
+	}
+
+	for _, method := range c.AllowedMethods {
+		if denied[method] {
+			return fmt.Errorf("method '%s' is both allowed and denied", method)
+		}
+	}
+
 	// TODO: validate APIs
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -143,6 +223,17 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		Address:   DefaultJSONRPCAddress,
 		WsAddress: DefaultJSONRPCWsAddress,
 		GasCap:    DefaultGasCap,
+
+		GasPriceBlocks:     DefaultGasPriceBlocks,
+		GasPricePercentile: DefaultGasPricePercentile,
+		GasPriceDefault:    DefaultGasPriceDefault,
+
+		AllowedMethods:  []string{},
+		DeniedMethods:   []string{},
+		MaxBatchSize:    DefaultMaxBatchSize,
+		MaxResponseSize: DefaultMaxResponseSize,
+		RateLimit:       DefaultRateLimit,
+		RateBurst:       DefaultRateBurst,
 	}
 }
 
@@ -170,6 +261,17 @@ func GetConfig(v *viper.Viper) Config {
 			Address:   v.GetString("json-rpc.address"),
 			WsAddress: v.GetString("json-rpc.ws-address"),
 			GasCap:    v.GetUint64("json-rpc.gas-cap"),
+
+			GasPriceBlocks:     v.GetInt("json-rpc.gas-price-blocks"),
+			GasPricePercentile: v.GetInt("json-rpc.gas-price-percentile"),
+			GasPriceDefault:    v.GetUint64("json-rpc.gas-price-default"),
+
+			AllowedMethods:  v.GetStringSlice("json-rpc.allowed-methods"),
+			DeniedMethods:   v.GetStringSlice("json-rpc.denied-methods"),
+			MaxBatchSize:    v.GetInt("json-rpc.max-batch-size"),
+			MaxResponseSize: v.GetInt("json-rpc.max-response-size"),
+			RateLimit:       v.GetFloat64("json-rpc.rate-limit"),
+			RateBurst:       v.GetInt("json-rpc.rate-burst"),
 		},
 	}
 }
@@ -12,3 +12,29 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.GasPriceBlocks = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.GasPricePercentile = 101
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.MaxBatchSize = -1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.RateLimit = 10
+	cfg.RateBurst = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.AllowedMethods = []string{"eth_call", "eth_getLogs"}
+	cfg.DeniedMethods = []string{"eth_getLogs"}
+	require.Error(t, cfg.Validate())
+}
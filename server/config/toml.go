@@ -34,4 +34,36 @@ api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
 gas-cap = {{ .JSONRPC.GasCap }}
+
+# GasPriceBlocks defines the number of recent blocks sampled by the eth_gasPrice oracle.
+gas-price-blocks = {{ .JSONRPC.GasPriceBlocks }}
+
+# GasPricePercentile defines the percentile of the sampled gas prices suggested by the eth_gasPrice oracle.
+gas-price-percentile = {{ .JSONRPC.GasPricePercentile }}
+
+# GasPriceDefault defines the gas price (in wei) suggested by the eth_gasPrice oracle when no transactions
+# are found in the sampled blocks. It is also the lowest gas price suggested by the oracle.
+gas-price-default = {{ .JSONRPC.GasPriceDefault }}
+
+# AllowedMethods defines the list of the JSON-RPC methods that can be called. All the methods of the
+# enabled namespaces can be called if empty.
+# Example: "eth_blockNumber,eth_getBalance,eth_sendRawTransaction"
+allowed-methods = "{{range $index, $elmt := .JSONRPC.AllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# DeniedMethods defines the list of the JSON-RPC methods that cannot be called.
+# Example: "eth_getLogs,debug_traceTransaction"
+denied-methods = "{{range $index, $elmt := .JSONRPC.DeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MaxBatchSize defines the maximum number of calls in a batch request (0=unlimited).
+max-batch-size = {{ .JSONRPC.MaxBatchSize }}
+
+# MaxResponseSize defines the maximum size in bytes of a response (0=unlimited).
+max-response-size = {{ .JSONRPC.MaxResponseSize }}
+
+# RateLimit defines the number of calls per second allowed for each client IP (0=unlimited).
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateBurst defines the maximum number of calls that a client IP can perform at once when the rate
+# limit is enabled.
+rate-burst = {{ .JSONRPC.RateBurst }}
 `
@@ -31,6 +31,17 @@ const (
 	JSONRPCAddress = "json-rpc.address"
 	JSONWsAddress  = "json-rpc.ws-address"
 	JSONRPCGasCap  = "json-rpc.gas-cap"
+
+	JSONRPCGasPriceBlocks     = "json-rpc.gas-price-blocks"
+	JSONRPCGasPricePercentile = "json-rpc.gas-price-percentile"
+	JSONRPCGasPriceDefault    = "json-rpc.gas-price-default"
+
+	JSONRPCAllowedMethods  = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods   = "json-rpc.denied-methods"
+	JSONRPCMaxBatchSize    = "json-rpc.max-batch-size"
+	JSONRPCMaxResponseSize = "json-rpc.max-response-size"
+	JSONRPCRateLimit       = "json-rpc.rate-limit"
+	JSONRPCRateBurst       = "json-rpc.rate-burst"
 )
 
 // EVM flags
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/tharsis/ethermint/ethereum/rpc"
+	"github.com/tharsis/ethermint/ethereum/rpc/middleware"
 
 	"github.com/tharsis/ethermint/server/config"
 )
@@ -37,8 +38,11 @@ func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEn
 		}
 	}
 
+	// method lists, batch and response size limits and rate limits of the JSON-RPC calls
+	mw := middleware.New(ctx.Logger, config.JSONRPC)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", mw.Handler(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -77,7 +81,7 @@ func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEn
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(ctx.Logger, tmWsClient, "localhost:"+port, config.JSONRPC.WsAddress)
+	wsSrv := rpc.NewWebsocketsServer(ctx.Logger, tmWsClient, mw, "localhost:"+port, config.JSONRPC.WsAddress)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -144,6 +144,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas (0=infinite)")
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceBlocks, config.DefaultGasPriceBlocks, "Sets the number of recent blocks sampled by the eth_gasPrice oracle")
+	cmd.Flags().Int(srvflags.JSONRPCGasPricePercentile, config.DefaultGasPricePercentile, "Sets the percentile of the sampled gas prices suggested by the eth_gasPrice oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGasPriceDefault, config.DefaultGasPriceDefault, "Sets the default and lowest gas price (in wei) suggested by the eth_gasPrice oracle")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, []string{}, "Defines a list of JSON-RPC methods that can be called (empty=all the methods of the enabled namespaces)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, []string{}, "Defines a list of JSON-RPC methods that cannot be called")
+	cmd.Flags().Int(srvflags.JSONRPCMaxBatchSize, config.DefaultMaxBatchSize, "Sets the maximum number of calls in a JSON-RPC batch request (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCMaxResponseSize, config.DefaultMaxResponseSize, "Sets the maximum size in bytes of a JSON-RPC response (0=unlimited)")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, config.DefaultRateLimit, "Sets the number of JSON-RPC calls per second allowed for each client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateBurst, config.DefaultRateBurst, "Sets the maximum number of JSON-RPC calls that a client IP can perform at once")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
 
@@ -0,0 +1,18 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tharsis/ethermint/x/erc20/client/cli"
+	"github.com/tharsis/ethermint/x/erc20/client/rest"
+)
+
+var (
+	// RegisterCoinProposalHandler is the governance client handler of the register coin proposals
+	RegisterCoinProposalHandler = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRESTHandler)
+	// RegisterERC20ProposalHandler is the governance client handler of the register ERC-20 proposals
+	RegisterERC20ProposalHandler = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRESTHandler)
+	// ToggleTokenConversionProposalHandler is the governance client handler of the toggle token
+	// conversion proposals
+	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd, rest.ToggleTokenConversionProposalRESTHandler)
+)
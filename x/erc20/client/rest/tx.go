@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+// RegisterCoinProposalRequest defines a request for a new register coin proposal.
+type RegisterCoinProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Denom       string       `json:"denom" yaml:"denom"`
+}
+
+// RegisterERC20ProposalRequest defines a request for a new register ERC-20 proposal.
+type RegisterERC20ProposalRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	ERC20Address string       `json:"erc20_address" yaml:"erc20_address"`
+}
+
+// ToggleTokenConversionProposalRequest defines a request for a new toggle token conversion
+// proposal.
+type ToggleTokenConversionProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token       string       `json:"token" yaml:"token"`
+}
+
+// RegisterCoinProposalRESTHandler returns the REST handler of the register coin proposals
+func RegisterCoinProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_coin",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RegisterCoinProposalRequest
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewRegisterCoinProposal(req.Title, req.Description, req.Denom)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+// RegisterERC20ProposalRESTHandler returns the REST handler of the register ERC-20 proposals
+func RegisterERC20ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_erc20",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RegisterERC20ProposalRequest
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			if !common.IsHexAddress(req.ERC20Address) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid ERC-20 contract address")
+				return
+			}
+
+			content := types.NewRegisterERC20Proposal(req.Title, req.Description, common.HexToAddress(req.ERC20Address))
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+// ToggleTokenConversionProposalRESTHandler returns the REST handler of the toggle token conversion
+// proposals
+func ToggleTokenConversionProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "toggle_token_conversion",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ToggleTokenConversionProposalRequest
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewToggleTokenConversionProposal(req.Title, req.Description, req.Token)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func writeProposalTx(
+	clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins,
+) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -0,0 +1,115 @@
+// Package contracts defines the canonical ERC-20 contract that the erc20 module deploys for the
+// native Cosmos coins. Its runtime code is written in EVM assembly in ERC20MinterBurner.easm and
+// compiled with the go-ethereum assembler.
+package contracts
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// storage slots of the ERC20MinterBurner contract, see ERC20MinterBurner.easm
+const (
+	ownerSlot        = 0x01
+	decimalsSlot     = 0x02
+	nameLengthSlot   = 0x03
+	symbolLengthSlot = 0x04
+	nameBaseSlot     = 0x1000
+	symbolBaseSlot   = 0x2000
+)
+
+// MaxMetadataLength is the maximum length in bytes of the name and the symbol of the contract
+const MaxMetadataLength = 128
+
+var (
+	//go:embed ERC20MinterBurner.easm
+	erc20Source []byte
+	//go:embed ERC20MinterBurner.json
+	erc20ABIJSON []byte
+
+	// ERC20MinterBurnerABI is the ABI of the canonical ERC-20 contract. It's a superset of the
+	// ERC-20 standard, and is used to call the external ERC-20 contracts too.
+	ERC20MinterBurnerABI abi.ABI
+	// ERC20MinterBurnerRuntimeCode is the runtime code of the canonical ERC-20 contract
+	ERC20MinterBurnerRuntimeCode []byte
+)
+
+func init() {
+	var err error
+	ERC20MinterBurnerABI, err = abi.JSON(bytes.NewReader(erc20ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex(erc20Source, false))
+	bin, errs := compiler.Compile()
+	if len(errs) > 0 {
+		panic(fmt.Errorf("failed to compile ERC20MinterBurner: %v", errs))
+	}
+	ERC20MinterBurnerRuntimeCode = common.FromHex(bin)
+}
+
+// ERC20MinterBurnerCreationCode returns the creation code of the canonical ERC-20 contract with the
+// given metadata. The deployer of the contract is its owner. The name and the symbol must not be
+// longer than MaxMetadataLength.
+func ERC20MinterBurnerCreationCode(name, symbol string, decimals uint8) []byte {
+	var code []byte
+	code = append(code, storeString(nameLengthSlot, nameBaseSlot, name)...)
+	code = append(code, storeString(symbolLengthSlot, symbolBaseSlot, symbol)...)
+	code = append(code,
+		byte(vm.PUSH1), decimals, byte(vm.PUSH1), decimalsSlot, byte(vm.SSTORE),
+		byte(vm.CALLER), byte(vm.PUSH1), ownerSlot, byte(vm.SSTORE),
+	)
+
+	// copy the runtime code, located after the 13 bytes below, to memory and return it
+	size := len(ERC20MinterBurnerRuntimeCode)
+	offset := len(code) + 13
+	code = append(code,
+		byte(vm.PUSH2), byte(size>>8), byte(size),
+		byte(vm.DUP1),
+		byte(vm.PUSH2), byte(offset>>8), byte(offset),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.RETURN),
+	)
+	return append(code, ERC20MinterBurnerRuntimeCode...)
+}
+
+// storeString returns the code storing the length of the string at the given slot, and its words
+// at the consecutive slots starting from base.
+func storeString(lengthSlot byte, base int, s string) []byte {
+	code := []byte{byte(vm.PUSH2), byte(len(s) >> 8), byte(len(s)), byte(vm.PUSH1), lengthSlot, byte(vm.SSTORE)}
+	for i := 0; i*32 < len(s); i++ {
+		end := (i + 1) * 32
+		if end > len(s) {
+			end = len(s)
+		}
+		word := common.RightPadBytes([]byte(s[i*32:end]), 32)
+		slot := base + i
+		code = append(code, byte(vm.PUSH32))
+		code = append(code, word...)
+		code = append(code, byte(vm.PUSH2), byte(slot>>8), byte(slot), byte(vm.SSTORE))
+	}
+	return code
+}
+
+// ValidateMetadata returns an error if the name or the symbol of a token can't be stored in the
+// canonical ERC-20 contract.
+func ValidateMetadata(name, symbol string) error {
+	if strings.TrimSpace(name) == "" || strings.TrimSpace(symbol) == "" {
+		return fmt.Errorf("name and symbol cannot be blank")
+	}
+	if len(name) > MaxMetadataLength || len(symbol) > MaxMetadataLength {
+		return fmt.Errorf("name and symbol cannot be longer than %d bytes", MaxMetadataLength)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/tharsis/ethermint/x/erc20/keeper"
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	k.SetParams(ctx, data.Params)
+
+	// ensure erc20 module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the erc20 module account has not been set")
+	}
+
+	for _, pair := range data.TokenPairs {
+		k.SetTokenPair(ctx, pair)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the erc20 module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		TokenPairs: k.GetAllTokenPairs(ctx),
+	}
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tharsis/ethermint/x/erc20/contracts"
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+// GasLimit is the gas limit of the ERC-20 contract calls and deployments of the module
+const GasLimit uint64 = 3_000_000
+
+// DeployERC20Contract deploys the canonical ERC-20 contract with the given metadata, owned by the
+// module address, and returns its address.
+func (k Keeper) DeployERC20Contract(ctx sdk.Context, name, symbol string, decimals uint8) (common.Address, error) {
+	if err := contracts.ValidateMetadata(name, symbol); err != nil {
+		return common.Address{}, sdkerrors.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+
+	// the account of the module address doesn't exist until the first deployment
+	nonce, _ := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	code := contracts.ERC20MinterBurnerCreationCode(name, symbol, decimals)
+
+	res, err := k.evmKeeper.CallEVM(ctx, types.ModuleAddress, nil, code, GasLimit, true)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrERC20Call, "failed to deploy the ERC-20 contract: %s", err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "deploy ERC-20 contract")
+
+	return crypto.CreateAddress(types.ModuleAddress, nonce), nil
+}
+
+// CallERC20 calls a method of the given ERC-20 contract from the given address, commits its state
+// changes and returns its unpacked outputs.
+func (k Keeper) CallERC20(
+	ctx sdk.Context, contract, from common.Address, method string, args ...interface{},
+) ([]interface{}, error) {
+	return k.callERC20(ctx, contract, from, true, method, args...)
+}
+
+// QueryERC20 calls a method of the given ERC-20 contract without committing its state changes,
+// and returns its unpacked outputs.
+func (k Keeper) QueryERC20(ctx sdk.Context, contract common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	return k.callERC20(ctx, contract, types.ModuleAddress, false, method, args...)
+}
+
+func (k Keeper) callERC20(
+	ctx sdk.Context, contract, from common.Address, commit bool, method string, args ...interface{},
+) ([]interface{}, error) {
+	erc20 := contracts.ERC20MinterBurnerABI
+
+	data, err := erc20.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrERC20Call, "failed to pack %s: %s", method, err)
+	}
+
+	res, err := k.evmKeeper.CallEVM(ctx, from, &contract, data, GasLimit, commit)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrERC20Call, "%s on %s: %s", method, contract, err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, fmt.Sprintf("ERC-20 %s", method))
+
+	out, err := erc20.Unpack(method, res.Ret)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrERC20Call, "failed to unpack the output of %s on %s: %s", method, contract, err)
+	}
+
+	return out, nil
+}
+
+// BalanceOf returns the ERC-20 token balance of the given account
+func (k Keeper) BalanceOf(ctx sdk.Context, contract, account common.Address) (*big.Int, error) {
+	out, err := k.QueryERC20(ctx, contract, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrERC20Call, "invalid balance of %s on %s", account, contract)
+	}
+	return balance, nil
+}
+
+// transferERC20 transfers the ERC-20 tokens and checks that the contract returns true, or nothing
+// as some ERC-20 contracts don't follow the standard.
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	erc20 := contracts.ERC20MinterBurnerABI
+
+	data, err := erc20.Pack("transfer", to, amount)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrERC20Call, "failed to pack transfer: %s", err)
+	}
+
+	res, err := k.evmKeeper.CallEVM(ctx, from, &contract, data, GasLimit, true)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrERC20Call, "transfer on %s: %s", contract, err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "ERC-20 transfer")
+
+	if len(res.Ret) == 0 {
+		return nil
+	}
+
+	out, err := erc20.Unpack("transfer", res.Ret)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrERC20Call, "failed to unpack the output of transfer on %s: %s", contract, err)
+	}
+	if success, _ := out[0].(bool); !success {
+		return sdkerrors.Wrapf(types.ErrERC20Call, "transfer on %s returned false", contract)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/x/erc20/contracts"
+	"github.com/tharsis/ethermint/x/erc20/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wraps the erc20 keeper to implement the EVM hooks
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks of the erc20 module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PreTxProcessing implements EvmHooks. It is a no-op.
+func (h Hooks) PreTxProcessing(_ sdk.Context, _ core.Message) error {
+	return nil
+}
+
+// PostTxExecution implements EvmHooks. It is a no-op.
+func (h Hooks) PostTxExecution(_ sdk.Context, _ core.Message, _ *evmtypes.MsgEthereumTxResponse) error {
+	return nil
+}
+
+// PostTxProcessing converts the ERC-20 tokens transferred to the module address by an Ethereum
+// transaction to the Cosmos coins of the sender. The tokens of the module contract are burned and
+// the escrowed native coins are released, while the other ERC-20 tokens stay escrowed by the module,
+// which mints the coins representing them.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ common.Hash, logs []*ethtypes.Log) error {
+	params := h.k.GetParams(ctx)
+	if !params.EnableErc20 || !params.EnableEvmHook {
+		return nil
+	}
+
+	transferEvent := contracts.ERC20MinterBurnerABI.Events["Transfer"]
+
+	for _, log := range logs {
+		// Transfer(address indexed from, address indexed to, uint256 value)
+		if len(log.Topics) != 3 || log.Topics[0] != transferEvent.ID {
+			continue
+		}
+
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		if to != types.ModuleAddress {
+			continue
+		}
+
+		pair, found := h.k.GetTokenPair(ctx, log.Address)
+		if !found {
+			continue
+		}
+
+		// the tokens can't be recovered once transferred to the module address
+		if !pair.Enabled {
+			return sdkerrors.Wrapf(types.ErrTokenPairDisabled, "token %s", pair.Erc20Address)
+		}
+
+		out, err := transferEvent.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil || len(out) != 1 {
+			return sdkerrors.Wrapf(types.ErrERC20Call, "invalid Transfer event of %s", pair.Erc20Address)
+		}
+		amount, ok := out[0].(*big.Int)
+		if !ok || amount.Sign() == 0 {
+			continue
+		}
+
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		if err := h.k.convertTransfer(ctx, pair, from, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertTransfer sends the Cosmos coins of the token pair to the sender of the ERC-20 tokens
+// received by the module address.
+func (k Keeper) convertTransfer(ctx sdk.Context, pair types.TokenPair, from common.Address, amount *big.Int) error {
+	coins := sdk.Coins{sdk.NewCoin(pair.Denom, sdk.NewIntFromBigInt(amount))}
+
+	switch {
+	case pair.IsNativeCoin():
+		if _, err := k.CallERC20(ctx, pair.GetERC20Contract(), types.ModuleAddress, "burn", types.ModuleAddress, amount); err != nil {
+			return err
+		}
+	case pair.IsNativeERC20():
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidTokenPair, "invalid contract owner %s", pair.ContractOwner)
+	}
+
+	receiver := sdk.AccAddress(from.Bytes())
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(types.AttributeKeySender, from.Hex()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
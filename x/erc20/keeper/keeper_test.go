@@ -0,0 +1,488 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/erc20/contracts"
+	"github.com/tharsis/ethermint/x/erc20/keeper"
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+const testDenom = "acoin"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.EthermintApp
+	address common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+
+	suite.address = tests.GenerateAddress()
+
+	// consensus key, the EVM requires the block proposer to be a validator
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(priv.PubKey().Address())
+
+	suite.app = app.Setup(checkTx)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Height:          1,
+		ChainID:         "ethermint_9000-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(suite.address.Bytes()), priv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+}
+
+// mintCoins mints the coins of the test denomination to the account
+func (suite *KeeperTestSuite) mintCoins(address common.Address, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, address.Bytes(), coins),
+	)
+}
+
+// registerCoin registers the test denomination and returns its token pair
+func (suite *KeeperTestSuite) registerCoin() types.TokenPair {
+	suite.mintCoins(suite.address, 1000)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, testDenom)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// deployERC20 deploys an ERC-20 contract owned by the test account, mints tokens to it and
+// registers the contract.
+func (suite *KeeperTestSuite) deployERC20(amount int64) types.TokenPair {
+	code := contracts.ERC20MinterBurnerCreationCode("Test Token", "TEST", 18)
+	_, err := suite.app.EvmKeeper.CallEVM(suite.ctx, suite.address, nil, code, keeper.GasLimit, true)
+	suite.Require().NoError(err)
+	contract := crypto.CreateAddress(suite.address, 0)
+
+	_, err = suite.app.Erc20Keeper.CallERC20(suite.ctx, contract, suite.address, "mint", suite.address, big.NewInt(amount))
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// transferToModule transfers ERC-20 tokens of the account to the module address, and returns the
+// logs of the transfer.
+func (suite *KeeperTestSuite) transferToModule(contract, from common.Address, amount int64) []*ethtypes.Log {
+	data, err := contracts.ERC20MinterBurnerABI.Pack("transfer", types.ModuleAddress, big.NewInt(amount))
+	suite.Require().NoError(err)
+
+	// the logs are stored in the transient store, under the hash of the current transaction
+	txHash := common.BytesToHash(tests.GenerateAddress().Bytes())
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	suite.app.EvmKeeper.SetTxHashTransient(txHash)
+
+	_, err = suite.app.EvmKeeper.CallEVM(suite.ctx, from, &contract, data, keeper.GasLimit, true)
+	suite.Require().NoError(err)
+	return suite.app.EvmKeeper.GetTxLogsTransient(txHash)
+}
+
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) int64 {
+	balance, err := suite.app.Erc20Keeper.BalanceOf(suite.ctx, contract, account)
+	suite.Require().NoError(err)
+	return balance.Int64()
+}
+
+func (suite *KeeperTestSuite) coinBalance(address common.Address, denom string) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, address.Bytes(), denom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) TestDeployERC20Contract() {
+	contract, err := suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, "Coin Token", "COIN", 6)
+	suite.Require().NoError(err)
+
+	keeper := suite.app.Erc20Keeper
+	out, err := keeper.QueryERC20(suite.ctx, contract, "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal("Coin Token", out[0])
+	out, err = keeper.QueryERC20(suite.ctx, contract, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("COIN", out[0])
+	out, err = keeper.QueryERC20(suite.ctx, contract, "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(6), out[0])
+	out, err = keeper.QueryERC20(suite.ctx, contract, "owner")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ModuleAddress, out[0])
+
+	// the next contract is deployed to a new address
+	next, err := suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, "Coin Token", "COIN", 6)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(contract, next)
+
+	_, err = suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, " ", "COIN", 6)
+	suite.Require().ErrorIs(err, types.ErrInvalidMetadata)
+}
+
+func (suite *KeeperTestSuite) TestERC20MinterBurner() {
+	keeper := suite.app.Erc20Keeper
+	contract, err := keeper.DeployERC20Contract(suite.ctx, "Coin Token", "COIN", 6)
+	suite.Require().NoError(err)
+
+	other := tests.GenerateAddress()
+
+	// only the owner can mint and burn
+	_, err = keeper.CallERC20(suite.ctx, contract, suite.address, "mint", suite.address, big.NewInt(100))
+	suite.Require().Error(err)
+	_, err = keeper.CallERC20(suite.ctx, contract, types.ModuleAddress, "mint", suite.address, big.NewInt(100))
+	suite.Require().NoError(err)
+	_, err = keeper.CallERC20(suite.ctx, contract, suite.address, "burn", suite.address, big.NewInt(10))
+	suite.Require().Error(err)
+	_, err = keeper.CallERC20(suite.ctx, contract, types.ModuleAddress, "burn", suite.address, big.NewInt(10))
+	suite.Require().NoError(err)
+	_, err = keeper.CallERC20(suite.ctx, contract, types.ModuleAddress, "burn", suite.address, big.NewInt(1000))
+	suite.Require().Error(err)
+
+	out, err := keeper.QueryERC20(suite.ctx, contract, "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(90), out[0].(*big.Int).Int64())
+
+	// transfers
+	out, err = keeper.CallERC20(suite.ctx, contract, suite.address, "transfer", other, big.NewInt(40))
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, out[0])
+	_, err = keeper.CallERC20(suite.ctx, contract, suite.address, "transfer", other, big.NewInt(51))
+	suite.Require().Error(err)
+	_, err = keeper.CallERC20(suite.ctx, contract, suite.address, "transfer", common.Address{}, big.NewInt(1))
+	suite.Require().Error(err)
+	suite.Require().Equal(int64(50), suite.balanceOf(contract, suite.address))
+	suite.Require().Equal(int64(40), suite.balanceOf(contract, other))
+
+	// allowances
+	_, err = keeper.CallERC20(suite.ctx, contract, suite.address, "approve", other, big.NewInt(30))
+	suite.Require().NoError(err)
+	out, err = keeper.QueryERC20(suite.ctx, contract, "allowance", suite.address, other)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(30), out[0].(*big.Int).Int64())
+
+	_, err = keeper.CallERC20(suite.ctx, contract, other, "transferFrom", suite.address, other, big.NewInt(31))
+	suite.Require().Error(err)
+	_, err = keeper.CallERC20(suite.ctx, contract, other, "transferFrom", suite.address, other, big.NewInt(20))
+	suite.Require().NoError(err)
+	out, err = keeper.QueryERC20(suite.ctx, contract, "allowance", suite.address, other)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), out[0].(*big.Int).Int64())
+	suite.Require().Equal(int64(30), suite.balanceOf(contract, suite.address))
+	suite.Require().Equal(int64(60), suite.balanceOf(contract, other))
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoin() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		denom    string
+		expPass  bool
+	}{
+		{
+			"no supply",
+			func() {},
+			testDenom,
+			false,
+		},
+		{
+			"erc20 module disabled",
+			func() {
+				suite.mintCoins(suite.address, 10)
+				suite.app.Erc20Keeper.SetParams(suite.ctx, types.NewParams(false, true))
+			},
+			testDenom,
+			false,
+		},
+		{
+			"coin representing an ERC-20 token",
+			func() {},
+			types.CreateDenom(tests.GenerateAddress()),
+			false,
+		},
+		{
+			"already registered",
+			func() {
+				suite.registerCoin()
+			},
+			testDenom,
+			false,
+		},
+		{
+			"coin without metadata",
+			func() {
+				suite.mintCoins(suite.address, 10)
+			},
+			testDenom,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, tc.denom)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.denom, pair.Denom)
+			suite.Require().True(pair.Enabled)
+			suite.Require().True(pair.IsNativeCoin())
+
+			stored, found := suite.app.Erc20Keeper.GetTokenPairByDenom(suite.ctx, tc.denom)
+			suite.Require().True(found)
+			suite.Require().Equal(*pair, stored)
+
+			out, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract(), "symbol")
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.denom, out[0])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoinMetadata() {
+	suite.mintCoins(suite.address, 10)
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Description: "The test coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+		Base:    testDenom,
+		Display: "coin",
+		Name:    "Test Coin",
+		Symbol:  "COIN",
+	})
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, testDenom)
+	suite.Require().NoError(err)
+
+	contract := pair.GetERC20Contract()
+	out, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract, "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal("Test Coin", out[0])
+	out, err = suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("COIN", out[0])
+	out, err = suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract, "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(18), out[0])
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20() {
+	pair := suite.deployERC20(100)
+	suite.Require().True(pair.IsNativeERC20())
+	suite.Require().Equal(types.CreateDenom(pair.GetERC20Contract()), pair.Denom)
+
+	// already registered
+	_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, pair.GetERC20Contract())
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+
+	// not a contract
+	_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, tests.GenerateAddress())
+	suite.Require().ErrorIs(err, types.ErrInvalidTokenPair)
+}
+
+func (suite *KeeperTestSuite) TestToggleConversion() {
+	pair := suite.registerCoin()
+
+	toggled, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Erc20Address)
+	suite.Require().NoError(err)
+	suite.Require().False(toggled.Enabled)
+
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 10), suite.address, suite.address.Bytes())
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairDisabled)
+
+	toggled, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, testDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(toggled.Enabled)
+
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, "unknown")
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeCoin() {
+	pair := suite.registerCoin()
+	contract := pair.GetERC20Contract()
+	receiver := tests.GenerateAddress()
+	moduleAcc := authtypes.NewModuleAddress(types.ModuleName)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 400), receiver, suite.address.Bytes())
+	_, err := suite.app.Erc20Keeper.ConvertCoin(goCtx, msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(600), suite.coinBalance(suite.address, testDenom))
+	suite.Require().Equal(int64(400), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAcc, testDenom).Amount.Int64())
+	suite.Require().Equal(int64(400), suite.balanceOf(contract, receiver))
+
+	// insufficient coins
+	msg = types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 601), receiver, suite.address.Bytes())
+	_, err = suite.app.Erc20Keeper.ConvertCoin(goCtx, msg)
+	suite.Require().Error(err)
+
+	back := types.NewMsgConvertERC20(sdk.NewInt(150), suite.address.Bytes(), contract, receiver)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(goCtx, back)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(750), suite.coinBalance(suite.address, testDenom))
+	suite.Require().Equal(int64(250), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAcc, testDenom).Amount.Int64())
+	suite.Require().Equal(int64(250), suite.balanceOf(contract, receiver))
+
+	// insufficient tokens
+	back = types.NewMsgConvertERC20(sdk.NewInt(251), suite.address.Bytes(), contract, receiver)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(goCtx, back)
+	suite.Require().Error(err)
+
+	// module accounts can't receive coins
+	back = types.NewMsgConvertERC20(sdk.NewInt(10), moduleAcc, contract, receiver)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(goCtx, back)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeERC20() {
+	pair := suite.deployERC20(1000)
+	contract := pair.GetERC20Contract()
+	receiver := tests.GenerateAddress()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	msg := types.NewMsgConvertERC20(sdk.NewInt(400), receiver.Bytes(), contract, suite.address)
+	_, err := suite.app.Erc20Keeper.ConvertERC20(goCtx, msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(600), suite.balanceOf(contract, suite.address))
+	suite.Require().Equal(int64(400), suite.balanceOf(contract, types.ModuleAddress))
+	suite.Require().Equal(int64(400), suite.coinBalance(receiver, pair.Denom))
+
+	back := types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 150), suite.address, receiver.Bytes())
+	_, err = suite.app.Erc20Keeper.ConvertCoin(goCtx, back)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(750), suite.balanceOf(contract, suite.address))
+	suite.Require().Equal(int64(250), suite.balanceOf(contract, types.ModuleAddress))
+	suite.Require().Equal(int64(250), suite.coinBalance(receiver, pair.Denom))
+	suite.Require().Equal(int64(250), suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	testCases := []struct {
+		name     string
+		malleate func() (types.TokenPair, common.Address)
+		expPass  bool
+	}{
+		{
+			"native coin",
+			func() (types.TokenPair, common.Address) {
+				pair := suite.registerCoin()
+				msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 400), suite.address, suite.address.Bytes())
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+				return pair, suite.address
+			},
+			true,
+		},
+		{
+			"native ERC-20",
+			func() (types.TokenPair, common.Address) {
+				return suite.deployERC20(400), suite.address
+			},
+			true,
+		},
+		{
+			"disabled token pair",
+			func() (types.TokenPair, common.Address) {
+				pair := suite.deployERC20(400)
+				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+				suite.Require().NoError(err)
+				return pair, suite.address
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair, sender := tc.malleate()
+			contract := pair.GetERC20Contract()
+			before := suite.coinBalance(sender, pair.Denom)
+
+			logs := suite.transferToModule(contract, sender, 100)
+			err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, common.Hash{}, logs)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(before+100, suite.coinBalance(sender, pair.Denom))
+			suite.Require().Equal(int64(300), suite.balanceOf(contract, sender))
+
+			if pair.IsNativeCoin() {
+				// the tokens received by the module are burned
+				suite.Require().Equal(int64(0), suite.balanceOf(contract, types.ModuleAddress))
+			} else {
+				suite.Require().Equal(int64(100), suite.balanceOf(contract, types.ModuleAddress))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingIgnoredLogs() {
+	pair := suite.deployERC20(400)
+	contract := pair.GetERC20Contract()
+
+	// the transfers to other accounts are ignored
+	_, err := suite.app.Erc20Keeper.CallERC20(suite.ctx, contract, suite.address, "transfer", tests.GenerateAddress(), big.NewInt(10))
+	suite.Require().NoError(err)
+
+	logs := suite.transferToModule(contract, suite.address, 100)
+	logs[0].Address = tests.GenerateAddress()
+
+	// the evm hook is disabled
+	suite.app.Erc20Keeper.SetParams(suite.ctx, types.NewParams(true, false))
+	suite.Require().NoError(suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, common.Hash{}, logs))
+	suite.Require().Equal(int64(0), suite.coinBalance(suite.address, pair.Denom))
+
+	// the token isn't registered
+	suite.app.Erc20Keeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.Require().NoError(suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, common.Hash{}, logs))
+	suite.Require().Equal(int64(0), suite.coinBalance(suite.address, pair.Denom))
+}
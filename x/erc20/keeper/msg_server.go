@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// ConvertCoin converts the Cosmos coins of the sender to the ERC-20 tokens of the receiver. The
+// native coins are escrowed by the module, which mints the tokens of its contract, while the coins
+// representing ERC-20 tokens are burned and the escrowed tokens are transferred.
+func (k Keeper) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := k.enabledTokenPair(ctx, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver := common.HexToAddress(msg.Receiver)
+	contract := pair.GetERC20Contract()
+	coins := sdk.Coins{msg.Coin}
+	amount := msg.Coin.Amount.BigInt()
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pair.IsNativeCoin():
+		if err := k.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return nil, err
+		}
+		if _, err := k.CallERC20(ctx, contract, types.ModuleAddress, "mint", receiver, amount); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeERC20():
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+		if err := k.transferERC20(ctx, contract, types.ModuleAddress, receiver, amount); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "invalid contract owner %s", pair.ContractOwner)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20, pair.Erc20Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// ConvertERC20 converts the ERC-20 tokens of the sender to the Cosmos coins of the receiver. The
+// tokens of the module contract are burned and the escrowed native coins are released, while the
+// other ERC-20 tokens are escrowed by the module, which mints the coins representing them.
+func (k Keeper) ConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := k.enabledTokenPair(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Receiver)
+	}
+
+	sender := common.HexToAddress(msg.Sender)
+	contract := pair.GetERC20Contract()
+	coins := sdk.Coins{sdk.NewCoin(pair.Denom, msg.Amount)}
+	amount := msg.Amount.BigInt()
+
+	switch {
+	case pair.IsNativeCoin():
+		if _, err := k.CallERC20(ctx, contract, types.ModuleAddress, "burn", sender, amount); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeERC20():
+		if err := k.escrowERC20(ctx, contract, sender, amount); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "invalid contract owner %s", pair.ContractOwner)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20, pair.Erc20Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(sender.Bytes()).String()),
+		),
+	})
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// enabledTokenPair returns the token pair of the token if the conversions are enabled for it
+func (k Keeper) enabledTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	pair, found := k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token %s", token)
+	}
+	if !pair.Enabled {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairDisabled, "token %s", token)
+	}
+
+	return pair, nil
+}
+
+// escrowERC20 transfers the ERC-20 tokens from the sender to the module address, and checks that
+// the module received the whole amount, as some ERC-20 contracts charge fees on transfers.
+func (k Keeper) escrowERC20(ctx sdk.Context, contract, sender common.Address, amount *big.Int) error {
+	before, err := k.BalanceOf(ctx, contract, types.ModuleAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.transferERC20(ctx, contract, sender, types.ModuleAddress, amount); err != nil {
+		return err
+	}
+
+	after, err := k.BalanceOf(ctx, contract, types.ModuleAddress)
+	if err != nil {
+		return err
+	}
+
+	if received := new(big.Int).Sub(after, before); received.Cmp(amount) != 0 {
+		return sdkerrors.Wrapf(
+			types.ErrERC20Call, "the module received %s tokens of %s instead of %s", received, contract, amount,
+		)
+	}
+
+	return nil
+}
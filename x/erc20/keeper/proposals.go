@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+// RegisterCoin registers a token pair for the native Cosmos coin denomination, and deploys the
+// canonical ERC-20 contract representing it. The contract metadata is read from the bank
+// denomination metadata if any.
+func (k Keeper) RegisterCoin(ctx sdk.Context, denom string) (*types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return nil, types.ErrERC20Disabled
+	}
+
+	if k.IsDenomRegistered(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairAlreadyExists, "coin denomination %s", denom)
+	}
+
+	if strings.HasPrefix(denom, types.ERC20DenomPrefix) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "coin %s already represents an ERC-20 token", denom)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); !supply.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "coin denomination %s has no supply", denom)
+	}
+
+	name, symbol, decimals, err := k.coinMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := k.DeployERC20Contract(ctx, name, symbol, decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_MODULE)
+	k.SetTokenPair(ctx, pair)
+	return &pair, nil
+}
+
+// coinMetadata returns the name, the symbol and the decimals of the ERC-20 contract representing
+// the coin. The decimals are the exponent of the display unit of the coin. The denomination is
+// used as name and symbol, with 0 decimals, if the coin has no metadata.
+func (k Keeper) coinMetadata(ctx sdk.Context, denom string) (string, string, uint8, error) {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return denom, denom, 0, nil
+	}
+
+	name, symbol := metadata.Name, metadata.Symbol
+	if name == "" {
+		name = metadata.Display
+	}
+	if symbol == "" {
+		symbol = metadata.Display
+	}
+
+	var exponent uint32
+	for _, unit := range metadata.DenomUnits {
+		if unit != nil && unit.Denom == metadata.Display {
+			exponent = unit.Exponent
+			break
+		}
+	}
+	if exponent > math.MaxUint8 {
+		return "", "", 0, sdkerrors.Wrapf(types.ErrInvalidMetadata, "exponent %d of %s is too large", exponent, denom)
+	}
+
+	if name == "" || symbol == "" {
+		return denom, denom, uint8(exponent), nil
+	}
+	return name, symbol, uint8(exponent), nil
+}
+
+// RegisterERC20 registers a token pair for the existing ERC-20 contract. The coin representing the
+// ERC-20 tokens is minted by the module when the tokens are converted.
+func (k Keeper) RegisterERC20(ctx sdk.Context, contract common.Address) (*types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return nil, types.ErrERC20Disabled
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairAlreadyExists, "ERC-20 contract %s", contract)
+	}
+
+	denom := types.CreateDenom(contract)
+	if k.IsDenomRegistered(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairAlreadyExists, "coin denomination %s", denom)
+	}
+
+	// the contract must exist and implement the ERC-20 interface
+	if _, err := k.QueryERC20(ctx, contract, "totalSupply"); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenPair, "%s is not an ERC-20 contract: %s", contract, err)
+	}
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_EXTERNAL)
+	k.SetTokenPair(ctx, pair)
+	return &pair, nil
+}
+
+// ToggleConversion enables or disables the conversions of the token pair identified by the token,
+// which is either the hex address of the ERC-20 contract or the coin denomination.
+func (k Keeper) ToggleConversion(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token %s", token)
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
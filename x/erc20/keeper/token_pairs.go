@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/x/erc20/types"
+)
+
+// GetTokenPair returns the token pair of the given ERC-20 contract
+func (k Keeper) GetTokenPair(ctx sdk.Context, address common.Address) (types.TokenPair, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenPairKey(address))
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	var pair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// GetTokenPairByDenom returns the token pair of the given coin denomination
+func (k Keeper) GetTokenPairByDenom(ctx sdk.Context, denom string) (types.TokenPair, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenPairByDenomKey(denom))
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	return k.GetTokenPair(ctx, common.BytesToAddress(bz))
+}
+
+// GetTokenPairByToken returns the token pair of the given token, which is either the hex address of
+// an ERC-20 contract or a coin denomination.
+func (k Keeper) GetTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, bool) {
+	if common.IsHexAddress(token) {
+		return k.GetTokenPair(ctx, common.HexToAddress(token))
+	}
+	return k.GetTokenPairByDenom(ctx, token)
+}
+
+// SetTokenPair stores the token pair, indexed by its ERC-20 contract and its coin denomination
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	store := ctx.KVStore(k.storeKey)
+	address := pair.GetERC20Contract()
+
+	store.Set(types.TokenPairKey(address), k.cdc.MustMarshal(&pair))
+	store.Set(types.TokenPairByDenomKey(pair.Denom), address.Bytes())
+}
+
+// IsERC20Registered returns true if a token pair is registered for the ERC-20 contract
+func (k Keeper) IsERC20Registered(ctx sdk.Context, address common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TokenPairKey(address))
+}
+
+// IsDenomRegistered returns true if a token pair is registered for the coin denomination
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TokenPairByDenomKey(denom))
+}
+
+// IterateTokenPairs iterates over the token pairs, ordered by ERC-20 contract address, until the
+// callback returns true.
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(pair types.TokenPair) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+
+		if cb(pair) {
+			break
+		}
+	}
+}
+
+// GetAllTokenPairs returns all the registered token pairs
+func (k Keeper) GetAllTokenPairs(ctx sdk.Context) []types.TokenPair {
+	pairs := []types.TokenPair{}
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		pairs = append(pairs, pair)
+		return false
+	})
+	return pairs
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	codeErrERC20Disabled = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrTokenPairNotFound
+	codeErrTokenPairAlreadyExists
+	codeErrTokenPairDisabled
+	codeErrInvalidTokenPair
+	codeErrInvalidMetadata
+	codeErrERC20Call
+)
+
+var (
+	// ErrERC20Disabled returns an error if the conversions are disabled through governance
+	ErrERC20Disabled = sdkerrors.Register(ModuleName, codeErrERC20Disabled, "erc20 module is disabled")
+
+	// ErrTokenPairNotFound returns an error if the token pair isn't registered
+	ErrTokenPairNotFound = sdkerrors.Register(ModuleName, codeErrTokenPairNotFound, "token pair not found")
+
+	// ErrTokenPairAlreadyExists returns an error if the token pair is already registered
+	ErrTokenPairAlreadyExists = sdkerrors.Register(ModuleName, codeErrTokenPairAlreadyExists, "token pair already exists")
+
+	// ErrTokenPairDisabled returns an error if the conversions of the token pair are disabled
+	ErrTokenPairDisabled = sdkerrors.Register(ModuleName, codeErrTokenPairDisabled, "token pair conversions are disabled")
+
+	// ErrInvalidTokenPair returns an error if the token pair is invalid
+	ErrInvalidTokenPair = sdkerrors.Register(ModuleName, codeErrInvalidTokenPair, "invalid token pair")
+
+	// ErrInvalidMetadata returns an error if the metadata of the token can't be used
+	ErrInvalidMetadata = sdkerrors.Register(ModuleName, codeErrInvalidMetadata, "invalid token metadata")
+
+	// ErrERC20Call returns an error if a call to an ERC-20 contract fails
+	ErrERC20Call = sdkerrors.Register(ModuleName, codeErrERC20Call, "ERC-20 contract call failed")
+)
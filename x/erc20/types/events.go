@@ -0,0 +1,18 @@
+package types
+
+// erc20 module events
+const (
+	EventTypeConvertCoin           = "convert_coin"
+	EventTypeConvertERC20          = "convert_erc20"
+	EventTypeRegisterCoin          = "register_coin"
+	EventTypeRegisterERC20         = "register_erc20"
+	EventTypeToggleTokenConversion = "toggle_token_conversion"
+
+	AttributeKeySender     = "sender"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyDenom      = "denom"
+	AttributeKeyERC20      = "erc20_address"
+	AttributeKeyEnabled    = "enabled"
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultGenesisState sets default erc20 genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		TokenPairs: []TokenPair{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenERC20 := make(map[common.Address]bool)
+	seenDenom := make(map[string]bool)
+
+	for _, pair := range gs.TokenPairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		address := pair.GetERC20Contract()
+		if seenERC20[address] {
+			return fmt.Errorf("duplicated token pair for the ERC-20 contract %s", address)
+		}
+		if seenDenom[pair.Denom] {
+			return fmt.Errorf("duplicated token pair for the denomination %s", pair.Denom)
+		}
+
+		seenERC20[address] = true
+		seenDenom[pair.Denom] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	address := tests.GenerateAddress()
+	pair := NewTokenPair(address, "acoin", OWNER_MODULE)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expError bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{
+			"valid token pairs",
+			&GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					pair,
+					NewTokenPair(tests.GenerateAddress(), "bcoin", OWNER_MODULE),
+				},
+			},
+			false,
+		},
+		{
+			"invalid token pair",
+			&GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{NewTokenPair(address, "acoin", OWNER_UNSPECIFIED)},
+			},
+			true,
+		},
+		{
+			"duplicated ERC-20 contract",
+			&GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{pair, NewTokenPair(address, "bcoin", OWNER_MODULE)},
+			},
+			true,
+		},
+		{
+			"duplicated denom",
+			&GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{pair, NewTokenPair(tests.GenerateAddress(), "acoin", OWNER_MODULE)},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
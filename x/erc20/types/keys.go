@@ -0,0 +1,46 @@
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "erc20"
+
+	// StoreKey key for the token pairs
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the EVM address of the erc20 module, which deploys and owns the canonical ERC-20
+// contracts and escrows the converted ERC-20 tokens. The converted coins are escrowed by the module
+// account instead. The module account can't be used in the EVM, as the sequence of module accounts,
+// and thus the nonce used to deploy contracts, can't be incremented.
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName + "/evm").Bytes())
+
+// prefix bytes for the erc20 persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByDenom
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+)
+
+// TokenPairKey returns the key of a token pair, indexed by the address of its ERC-20 contract
+func TokenPairKey(address common.Address) []byte {
+	return append(KeyPrefixTokenPair, address.Bytes()...)
+}
+
+// TokenPairByDenomKey returns the key of the ERC-20 contract address of a token pair, indexed by
+// its coin denomination
+func TokenPairByDenomKey(denom string) []byte {
+	return append(KeyPrefixTokenPairByDenom, []byte(denom)...)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgConvertERC20{}
+)
+
+const (
+	// TypeMsgConvertCoin defines the type string of a coin conversion
+	TypeMsgConvertCoin = "convert_coin"
+	// TypeMsgConvertERC20 defines the type string of an ERC-20 conversion
+	TypeMsgConvertERC20 = "convert_erc20"
+)
+
+// NewMsgConvertCoin creates a new MsgConvertCoin instance
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route returns the message route for a MsgConvertCoin.
+func (msg MsgConvertCoin) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgConvertCoin.
+func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoin) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin %s", msg.Coin)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC20 creates a new MsgConvertERC20 instance
+func NewMsgConvertERC20(amount sdk.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC20 {
+	return &MsgConvertERC20{
+		ContractAddress: contract.Hex(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// Route returns the message route for a MsgConvertERC20.
+func (msg MsgConvertERC20) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgConvertERC20.
+func (msg MsgConvertERC20) Type() string { return TypeMsgConvertERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid contract address")
+	}
+
+	if err := ethermint.ValidateAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+func TestMsgConvertCoin(t *testing.T) {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	receiver := tests.GenerateAddress()
+
+	msg := NewMsgConvertCoin(sdk.NewInt64Coin("acoin", 10), receiver, sender)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgConvertCoin, msg.Type())
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+	require.NoError(t, msg.ValidateBasic())
+
+	testCases := []struct {
+		name string
+		msg  *MsgConvertCoin
+	}{
+		{"zero coin", NewMsgConvertCoin(sdk.NewInt64Coin("acoin", 0), receiver, sender)},
+		{"invalid coin", &MsgConvertCoin{Coin: sdk.Coin{Denom: "1coin", Amount: sdk.NewInt(1)}, Receiver: receiver.Hex(), Sender: sender.String()}},
+		{"invalid receiver", &MsgConvertCoin{Coin: sdk.NewInt64Coin("acoin", 10), Receiver: "0x1", Sender: sender.String()}},
+		{"invalid sender", &MsgConvertCoin{Coin: sdk.NewInt64Coin("acoin", 10), Receiver: receiver.Hex(), Sender: "sender"}},
+	}
+
+	for _, tc := range testCases {
+		require.Error(t, tc.msg.ValidateBasic(), tc.name)
+	}
+}
+
+func TestMsgConvertERC20(t *testing.T) {
+	sender := tests.GenerateAddress()
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contract := tests.GenerateAddress()
+
+	msg := NewMsgConvertERC20(sdk.NewInt(10), receiver, contract, sender)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgConvertERC20, msg.Type())
+	require.Equal(t, []sdk.AccAddress{sender.Bytes()}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+	require.NoError(t, msg.ValidateBasic())
+
+	testCases := []struct {
+		name string
+		msg  *MsgConvertERC20
+	}{
+		{"zero amount", NewMsgConvertERC20(sdk.ZeroInt(), receiver, contract, sender)},
+		{"negative amount", NewMsgConvertERC20(sdk.NewInt(-1), receiver, contract, sender)},
+		{"invalid contract", &MsgConvertERC20{ContractAddress: "0x1", Amount: sdk.NewInt(10), Receiver: receiver.String(), Sender: sender.Hex()}},
+		{"invalid receiver", &MsgConvertERC20{ContractAddress: contract.Hex(), Amount: sdk.NewInt(10), Receiver: "receiver", Sender: sender.Hex()}},
+		{"invalid sender", &MsgConvertERC20{ContractAddress: contract.Hex(), Amount: sdk.NewInt(10), Receiver: receiver.String(), Sender: "0x1"}},
+	}
+
+	for _, tc := range testCases {
+		require.Error(t, tc.msg.ValidateBasic(), tc.name)
+	}
+}
+
+func TestValidateToken(t *testing.T) {
+	require.NoError(t, ValidateToken(common.Address{}.Hex()))
+	require.NoError(t, ValidateToken("acoin"))
+	require.NoError(t, ValidateToken(CreateDenom(tests.GenerateAddress())))
+	require.Error(t, ValidateToken("0x1"))
+	require.Error(t, ValidateToken(""))
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter keys
+var (
+	ParamStoreKeyEnableERC20   = []byte("EnableERC20")
+	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(enableERC20, enableEVMHook bool) Params {
+	return Params{
+		EnableErc20:   enableERC20,
+		EnableEvmHook: enableEVMHook,
+	}
+}
+
+// DefaultParams returns default erc20 module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableErc20:   true,
+		EnableEvmHook: true,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableERC20, &p.EnableErc20, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableEVMHook, &p.EnableEvmHook, validateBool),
+	}
+}
+
+// Validate performs basic validation on erc20 parameters.
+func (p Params) Validate() error {
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
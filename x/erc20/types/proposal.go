@@ -0,0 +1,123 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// constants
+const (
+	// ProposalTypeRegisterCoin defines the type for a RegisterCoinProposal
+	ProposalTypeRegisterCoin = "RegisterCoin"
+	// ProposalTypeRegisterERC20 defines the type for a RegisterERC20Proposal
+	ProposalTypeRegisterERC20 = "RegisterERC20"
+	// ProposalTypeToggleTokenConversion defines the type for a ToggleTokenConversionProposal
+	ProposalTypeToggleTokenConversion = "ToggleTokenConversion"
+)
+
+// Implements Proposal Interface
+var (
+	_ govtypes.Content = &RegisterCoinProposal{}
+	_ govtypes.Content = &RegisterERC20Proposal{}
+	_ govtypes.Content = &ToggleTokenConversionProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterCoin)
+	govtypes.RegisterProposalType(ProposalTypeRegisterERC20)
+	govtypes.RegisterProposalType(ProposalTypeToggleTokenConversion)
+	govtypes.RegisterProposalTypeCodec(&RegisterCoinProposal{}, "ethermint/RegisterCoinProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterERC20Proposal{}, "ethermint/RegisterERC20Proposal")
+	govtypes.RegisterProposalTypeCodec(&ToggleTokenConversionProposal{}, "ethermint/ToggleTokenConversionProposal")
+}
+
+// NewRegisterCoinProposal returns a new RegisterCoinProposal
+func NewRegisterCoinProposal(title, description, denom string) govtypes.Content {
+	return &RegisterCoinProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterCoinProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterCoinProposal) ProposalType() string { return ProposalTypeRegisterCoin }
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rcp *RegisterCoinProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(rcp.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return govtypes.ValidateAbstract(rcp)
+}
+
+// NewRegisterERC20Proposal returns a new RegisterERC20Proposal
+func NewRegisterERC20Proposal(title, description string, address common.Address) govtypes.Content {
+	return &RegisterERC20Proposal{
+		Title:        title,
+		Description:  description,
+		Erc20Address: address.Hex(),
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterERC20Proposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterERC20Proposal) ProposalType() string { return ProposalTypeRegisterERC20 }
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rep *RegisterERC20Proposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(rep.Erc20Address); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(rep)
+}
+
+// NewToggleTokenConversionProposal returns a new ToggleTokenConversionProposal
+func NewToggleTokenConversionProposal(title, description, token string) govtypes.Content {
+	return &ToggleTokenConversionProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*ToggleTokenConversionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*ToggleTokenConversionProposal) ProposalType() string {
+	return ProposalTypeToggleTokenConversion
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (ttcp *ToggleTokenConversionProposal) ValidateBasic() error {
+	if err := ValidateToken(ttcp.Token); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(ttcp)
+}
+
+// ValidateToken returns an error if the token is neither a hex address nor a coin denomination
+func ValidateToken(token string) error {
+	if common.IsHexAddress(token) {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(token); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTokenPair, "token %s is neither an address nor a denomination", token)
+	}
+
+	return nil
+}
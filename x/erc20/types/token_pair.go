@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// ERC20DenomPrefix is the prefix of the denominations of the coins representing ERC-20 tokens
+const ERC20DenomPrefix = "erc20/"
+
+// CreateDenom returns the denomination of the coin representing the given ERC-20 contract
+func CreateDenom(address common.Address) string {
+	return ERC20DenomPrefix + address.Hex()
+}
+
+// NewTokenPair returns a new enabled token pair
+func NewTokenPair(address common.Address, denom string, owner Owner) TokenPair {
+	return TokenPair{
+		Erc20Address:  address.Hex(),
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: owner,
+	}
+}
+
+// GetERC20Contract returns the address of the ERC-20 contract of the token pair
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// IsNativeCoin returns true if the token pair represents a native Cosmos coin
+func (tp TokenPair) IsNativeCoin() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC20 returns true if the token pair represents an ERC-20 token
+func (tp TokenPair) IsNativeERC20() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// Validate performs a stateless validation of the token pair
+func (tp TokenPair) Validate() error {
+	if err := ethermint.ValidateAddress(tp.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return err
+	}
+
+	switch tp.ContractOwner {
+	case OWNER_MODULE:
+		if strings.HasPrefix(tp.Denom, ERC20DenomPrefix) {
+			return fmt.Errorf("native coin denomination cannot have the %s prefix: %s", ERC20DenomPrefix, tp.Denom)
+		}
+	case OWNER_EXTERNAL:
+		if tp.Denom != CreateDenom(tp.GetERC20Contract()) {
+			return fmt.Errorf("invalid denomination %s for the ERC-20 token %s", tp.Denom, tp.Erc20Address)
+		}
+	default:
+		return fmt.Errorf("invalid contract owner %s", tp.ContractOwner)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+func TestTokenPairValidate(t *testing.T) {
+	address := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		pair     TokenPair
+		expError bool
+	}{
+		{"native coin", NewTokenPair(address, "acoin", OWNER_MODULE), false},
+		{"native ERC-20", NewTokenPair(address, CreateDenom(address), OWNER_EXTERNAL), false},
+		{"invalid address", TokenPair{Erc20Address: "0x1", Denom: "acoin", ContractOwner: OWNER_MODULE}, true},
+		{"invalid denom", NewTokenPair(address, "1coin", OWNER_MODULE), true},
+		{"native coin with the erc20 prefix", NewTokenPair(address, CreateDenom(address), OWNER_MODULE), true},
+		{"native ERC-20 with another denom", NewTokenPair(address, "acoin", OWNER_EXTERNAL), true},
+		{"native ERC-20 with the denom of another contract", NewTokenPair(address, CreateDenom(tests.GenerateAddress()), OWNER_EXTERNAL), true},
+		{"unspecified owner", NewTokenPair(address, "acoin", OWNER_UNSPECIFIED), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.pair.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestTokenPairOwner(t *testing.T) {
+	pair := NewTokenPair(tests.GenerateAddress(), "acoin", OWNER_MODULE)
+	require.True(t, pair.Enabled)
+	require.True(t, pair.IsNativeCoin())
+	require.False(t, pair.IsNativeERC20())
+
+	pair.ContractOwner = OWNER_EXTERNAL
+	require.False(t, pair.IsNativeCoin())
+	require.True(t, pair.IsNativeERC20())
+}
@@ -1,15 +1,21 @@
 package cli
 
 import (
+	"encoding/json"
+
 	"github.com/spf13/cobra"
 	rpctypes "github.com/tharsis/ethermint/ethereum/rpc/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
+	"github.com/tharsis/ethermint/server/config"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
 
+// FlagGasCap defines the gas cap flag of the call queries
+const FlagGasCap = "gas-cap"
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +29,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetStorageCmd(),
 		GetCodeCmd(),
+		GetCreateAccessListCmd(),
+		GetDeploymentAllowlistCmd(),
+		GetCallBlocklistCmd(),
+		GetUpcomingForksCmd(),
 	)
 	return cmd
 }
@@ -103,3 +113,146 @@ func GetCodeCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetDeploymentAllowlistCmd queries the addresses allowed to deploy contracts
+func GetDeploymentAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment-allowlist",
+		Short: "Gets the addresses allowed to deploy contracts",
+		Long:  "Gets the addresses allowed to deploy contracts. Any address can deploy contracts if the list is empty.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeploymentAllowlist(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryDeploymentAllowlistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCallBlocklistCmd queries the callers that are not allowed to call a given contract
+func GetCallBlocklistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call-blocklist [contract]",
+		Short: "Gets the callers that are not allowed to call a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCallBlocklistRequest{
+				Contract: contract,
+			}
+
+			res, err := queryClient.CallBlocklist(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetUpcomingForksCmd queries the hard forks and the extra EIPs scheduled after the current height
+func GetUpcomingForksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-forks",
+		Short: "Gets the hard forks and the extra EIPs scheduled after the current height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpcomingForks(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryUpcomingForksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCreateAccessListCmd computes the access list of a given call
+func GetCreateAccessListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-access-list [call-args]",
+		Short: "Creates the access list of a call",
+		Long: `Creates the access list of the addresses and storage keys accessed by a call, and returns it along with the gas used by the call once the access list is applied. The call arguments are provided in the same JSON format as the eth_call JSON-RPC method. If the height is not provided, it will use the latest height from context. The gas of the call is capped by the --gas-cap flag (0=infinite), which defaults to the gas cap of the JSON-RPC server.
+
+Example:
+$ ethermintd query evm create-access-list '{"from":"0x...","to":"0x...","data":"0x..."}'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var callArgs types.CallArgs
+			if err := json.Unmarshal([]byte(args[0]), &callArgs); err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(&callArgs)
+			if err != nil {
+				return err
+			}
+
+			gasCap, err := cmd.Flags().GetUint64(FlagGasCap)
+			if err != nil {
+				return err
+			}
+
+			req := &types.EthCallRequest{
+				Args:   bz,
+				GasCap: gasCap,
+			}
+
+			res, err := queryClient.CreateAccessList(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagGasCap, config.DefaultGasCap, "gas cap of the call (0=infinite)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// proposal flags
+const (
+	FlagFork      = "fork"
+	FlagExtraEIPs = "extra-eips"
+)
+
+// NewTxCmd returns the parent command for all x/evm CLI transaction commands.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "evm subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewRawTxCmd(),
+	)
+	return cmd
+}
+
+// NewRawTxCmd broadcasts a signed Ethereum transaction, with its fees optionally paid by a fee
+// granter
+func NewRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "raw [tx-hex]",
+		Short: "Broadcast a signed Ethereum transaction",
+		Long: `Broadcast a signed Ethereum transaction, given as the hex encoding of its RLP or typed
+transaction envelope. The fees of the transaction can be paid by a fee granter through a fee
+allowance to the sender, given with the --fee-account flag.`,
+		Example: "raw 0xf86c808504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a0... --fee-account=ethm1...",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := hexutil.Decode(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid transaction hex: %w", err)
+			}
+
+			ethTx := new(ethtypes.Transaction)
+			if err := ethTx.UnmarshalBinary(data); err != nil {
+				return fmt.Errorf("failed to decode Ethereum transaction: %w", err)
+			}
+
+			msg := &types.MsgEthereumTx{}
+			msg.FromEthereumTx(ethTx)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			builder, ok := clientCtx.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+			if !ok {
+				return fmt.Errorf("unsupported transaction builder %T", clientCtx.TxConfig.NewTxBuilder())
+			}
+
+			option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEthereumTx{})
+			if err != nil {
+				return err
+			}
+
+			builder.SetExtensionOptions(option)
+			if err := builder.SetMsgs(msg); err != nil {
+				return err
+			}
+
+			txData, err := types.UnpackTxData(msg.Data)
+			if err != nil {
+				return err
+			}
+
+			builder.SetFeeAmount(sdk.Coins{sdk.NewCoin(res.Params.EvmDenom, sdk.NewIntFromBigInt(txData.Fee()))})
+			builder.SetGasLimit(txData.GetGas())
+			builder.SetFeeGranter(clientCtx.FeeGranter)
+
+			if clientCtx.GenerateOnly {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			rsp, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(rsp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewScheduleForkProposalCmd submits a proposal to schedule a hard fork and extra EIPs
+func NewScheduleForkProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-fork [height]",
+		Short: "Submit a proposal to schedule a hard fork and extra EIPs at a future height",
+		Long: `Submit a proposal to schedule the activation of a hard fork of the chain config, and of
+extra EIPs, at a future block height, along with an initial deposit. The hard fork must be
+activated after the previous hard forks and before the next ones.`,
+		Example: "schedule-fork 1000000 --fork=berlin --extra-eips=2929 --title=Berlin --description=\"Activate Berlin\"",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fork, err := cmd.Flags().GetString(FlagFork)
+			if err != nil {
+				return err
+			}
+
+			extraEIPs, err := cmd.Flags().GetInt64Slice(FlagExtraEIPs)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewScheduleForkProposal(title, description, fork, height, extraEIPs)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFork, "", "name of the hard fork to activate, as defined by the chain config")
+	cmd.Flags().Int64Slice(FlagExtraEIPs, []int64{}, "extra EIPs to activate")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tharsis/ethermint/x/evm/client/cli"
+	"github.com/tharsis/ethermint/x/evm/client/rest"
+)
+
+// ScheduleForkProposalHandler is the governance client handler of the schedule fork proposals
+var ScheduleForkProposalHandler = govclient.NewProposalHandler(cli.NewScheduleForkProposalCmd, rest.ScheduleForkProposalRESTHandler)
@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// ScheduleForkProposalRequest defines a request for a new schedule fork proposal.
+type ScheduleForkProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Fork        string       `json:"fork" yaml:"fork"`
+	Height      uint64       `json:"height" yaml:"height"`
+	ExtraEIPs   []int64      `json:"extra_eips" yaml:"extra_eips"`
+}
+
+// ScheduleForkProposalRESTHandler returns the REST handler of the schedule fork proposals
+func ScheduleForkProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "schedule_fork",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ScheduleForkProposalRequest
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := types.NewScheduleForkProposal(req.Title, req.Description, req.Fork, req.Height, req.ExtraEIPs)
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
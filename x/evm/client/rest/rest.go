@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -81,13 +82,17 @@ func getEthTransactionByHash(clientCtx client.Context, hashHex string) ([]byte,
 
 	blockHash := common.BytesToHash(block.Block.Header.Hash())
 
-	ethTx, err := rpctypes.RawTxToEthTx(clientCtx, tx.Tx)
+	ethTxs, err := rpctypes.RawTxToEthTx(clientCtx, tx.Tx)
 	if err != nil {
 		return nil, err
 	}
+	if len(ethTxs) == 0 {
+		return nil, fmt.Errorf("no ethereum transaction in tx %s", hashHex)
+	}
 
+	// the first ethereum transaction is returned for the transactions with multiple messages
 	height := uint64(tx.Height)
-	rpcTx := rpctypes.NewTransaction(ethTx.AsTransaction(), blockHash, height, uint64(tx.Index))
+	rpcTx := rpctypes.NewTransaction(ethTxs[0].AsTransaction(), blockHash, height, uint64(tx.Index))
 
 	return json.Marshal(rpcTx)
 }
@@ -55,6 +55,10 @@ func InitGenesis(
 		}
 	}
 
+	for _, activation := range data.ScheduledEIPs {
+		k.SetScheduledEIPs(ctx, activation)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -87,8 +91,15 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	scheduledEIPs := []types.ForkActivation{}
+	k.IterateScheduledEIPs(ctx, func(activation types.ForkActivation) bool {
+		scheduledEIPs = append(scheduledEIPs, activation)
+		return false
+	})
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:      ethGenAccounts,
+		Params:        k.GetParams(ctx),
+		ScheduledEIPs: scheduledEIPs,
 	}
 }
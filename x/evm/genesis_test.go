@@ -104,3 +104,15 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestExportGenesisScheduledEIPs() {
+	genState := types.DefaultGenesisState()
+	genState.ScheduledEIPs = []types.ForkActivation{
+		{Height: 10, ExtraEIPs: []int64{1344}},
+		{Height: 20, ExtraEIPs: []int64{2200}},
+	}
+
+	_ = evm.InitGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper, *genState)
+	exported := evm.ExportGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper)
+	suite.Require().Equal(genState.ScheduledEIPs, exported.ScheduledEIPs)
+}
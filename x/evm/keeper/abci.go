@@ -8,15 +8,18 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, and activates the extra EIPs
+// scheduled at the current height.
 func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	k.WithContext(ctx)
 	k.WithChainID(ctx)
+
+	k.ActivateForks(ctx)
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, and emits the telemetry aggregates of the ethereum transactions of the block. The EVM end
+// block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
@@ -25,6 +28,8 @@ func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.Vali
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient().Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	k.emitBlockMetrics()
+
 	k.WithContext(ctx)
 
 	return []abci.ValidatorUpdate{}
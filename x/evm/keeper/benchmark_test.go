@@ -12,6 +12,7 @@ import (
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethermint "github.com/tharsis/ethermint/types"
+	bankprecompile "github.com/tharsis/ethermint/x/evm/precompiles/bank"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
 
@@ -33,9 +34,24 @@ func SetupContract(b *testing.B) (*KeeperTestSuite, common.Address) {
 
 type TxBuilder func(suite *KeeperTestSuite, contract common.Address) *types.MsgEthereumTx
 
+// ParamsMalleate modifies the module params used by a benchmark
+type ParamsMalleate func(params *types.Params)
+
 func DoBenchmark(b *testing.B, txBuilder TxBuilder) {
+	DoBenchmarkWithParams(b, txBuilder, nil)
+}
+
+// DoBenchmarkWithParams runs the benchmark of the given tx with the module params modified by the
+// given function, eg: to measure the overhead of the stateful precompiles or of the permissions.
+func DoBenchmarkWithParams(b *testing.B, txBuilder TxBuilder, malleate ParamsMalleate) {
 	suite, contractAddr := SetupContract(b)
 
+	if malleate != nil {
+		params := suite.app.EvmKeeper.GetParams(suite.ctx)
+		malleate(&params)
+		suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	}
+
 	msg := txBuilder(suite, contractAddr)
 	msg.From = suite.address.Hex()
 	err := msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer)
@@ -61,19 +77,53 @@ func DoBenchmark(b *testing.B, txBuilder TxBuilder) {
 }
 
 func BenchmarkTokenTransfer(b *testing.B) {
-	DoBenchmark(b, func(suite *KeeperTestSuite, contract common.Address) *types.MsgEthereumTx {
+	DoBenchmark(b, tokenTransferTx(b))
+}
+
+func tokenTransferTx(b *testing.B) TxBuilder {
+	return func(suite *KeeperTestSuite, contract common.Address) *types.MsgEthereumTx {
 		input, err := ContractABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(1000))
 		require.NoError(b, err)
 		nonce := suite.app.EvmKeeper.GetNonce(suite.address)
 		return types.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &contract, big.NewInt(0), 410000, big.NewInt(1), input, nil)
+	}
+}
+
+// BenchmarkTokenTransferWithPrecompiles measures the overhead of the interpreter hooks executing
+// the stateful precompiles, which are enabled on every transaction once a precompile is active.
+func BenchmarkTokenTransferWithPrecompiles(b *testing.B) {
+	DoBenchmarkWithParams(b, tokenTransferTx(b), func(params *types.Params) {
+		params.ActivePrecompiles = []string{bankprecompile.Address.Hex()}
+	})
+}
+
+// BenchmarkTokenTransferWithPermissions measures the overhead of the tracer identifying the callers
+// the contract deployment and call permissions.
+func BenchmarkTokenTransferWithPermissions(b *testing.B) {
+	DoBenchmarkWithParams(b, tokenTransferTx(b), func(params *types.Params) {
+		params.CallBlocklists = []types.CallBlocklist{
+			{Contract: bankprecompile.Address.Hex(), BlockedCallers: []string{common.Address{}.Hex()}},
+		}
 	})
 }
 
 func BenchmarkEmitLogs(b *testing.B) {
-	DoBenchmark(b, func(suite *KeeperTestSuite, contract common.Address) *types.MsgEthereumTx {
+	DoBenchmark(b, emitLogsTx(b))
+}
+
+// BenchmarkEmitLogsWithPrecompiles measures the overhead of the interpreter hooks executing the
+// stateful precompiles on a transaction executing many operations.
+func BenchmarkEmitLogsWithPrecompiles(b *testing.B) {
+	DoBenchmarkWithParams(b, emitLogsTx(b), func(params *types.Params) {
+		params.ActivePrecompiles = []string{bankprecompile.Address.Hex()}
+	})
+}
+
+func emitLogsTx(b *testing.B) TxBuilder {
+	return func(suite *KeeperTestSuite, contract common.Address) *types.MsgEthereumTx {
 		input, err := ContractABI.Pack("benchmarkLogs", big.NewInt(1000))
 		require.NoError(b, err)
 		nonce := suite.app.EvmKeeper.GetNonce(suite.address)
 		return types.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &contract, big.NewInt(0), 4100000, big.NewInt(1), input, nil)
-	})
+	}
 }
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// evmHook is both the StateDB of an EVM, forwarding to the keeper, and its tracer, forwarding the
+// traces to the underlying tracer, if any. It executes the stateful precompiled contracts enabled
+// when the EVM is created, and enforces the contract deployment and call permissions on the
+// internal calls and contract creations.
+//
+// NOTE: go-ethereum doesn't support custom precompiled contracts, nor does it provide the caller
+// and the value of a call to the default ones. Instead, the hook returns the stub code for the
+// address of the enabled stateful precompiles, and the precompile is executed from the tracer,
+// which is given the memory, the stack and the contract of the call. The interpreter then returns
+// or reverts with the output of the precompile, and the state changes are reverted along with
+// the snapshot taken by the EVM before the call.
+//
+// The tracer requires the EVM debug mode, which calls it on every operation executed by every
+// transaction once a stateful precompile is enabled. This adds a few percent to the execution time
+// of the transactions, as measured by the BenchmarkTokenTransferWithPrecompiles and
+// BenchmarkEmitLogsWithPrecompiles benchmarks, until the precompiles can be registered on the EVM
+// precompile set of a go-ethereum version supporting them. The operations of the stub code are not
+// forwarded to the underlying tracer, so that the traces show the stateful precompiles like the
+// default ones.
+//
+// Likewise, go-ethereum doesn't provide hooks on the entry of the internal calls and contract
+// creations, so the permissions are checked on the call and create operations, and the frame of a
+// blocked operation is failed on its entry, before any of its code runs: the hook returns a code
+// reverting immediately in place of the code of a blocked contract, and sets the value of a contract
+// creation by a deployer not allowed above any balance, which fails the creation on the balance check
+// of the EVM, before the nonce of the deployer is incremented.
+type evmHook struct {
+	*Keeper
+
+	tracer vm.Tracer
+	// precompiles is the set of the stateful precompiled contracts enabled on the EVM
+	precompiles map[common.Address]types.StatefulPrecompiledContract
+	// static records, for each call depth, whether the call is executed in read-only mode
+	static []bool
+
+	// permissions are the contract deployment and call permissions, nil if there are none
+	permissions *permissions
+	// blockedContract is the contract of the call operation being executed, if its caller is blocked
+	blockedContract *common.Address
+}
+
+var (
+	_ vm.StateDB = &evmHook{}
+	_ vm.Tracer  = &evmHook{}
+)
+
+func newEVMHook(
+	k *Keeper,
+	precompiles map[common.Address]types.StatefulPrecompiledContract,
+	permissions *permissions,
+	tracer vm.Tracer,
+) *evmHook {
+	return &evmHook{
+		Keeper:      k,
+		tracer:      tracer,
+		precompiles: precompiles,
+		permissions: permissions,
+	}
+}
+
+// GetCodeHash implements vm.StateDB. The code hash of an enabled stateful precompile is the one of
+// the precompile stub code.
+func (h *evmHook) GetCodeHash(addr common.Address) common.Hash {
+	if _, found := h.precompiles[addr]; found {
+		return types.PrecompileStubCodeHash
+	}
+	return h.Keeper.GetCodeHash(addr)
+}
+
+// GetCode implements vm.StateDB. The code of an enabled stateful precompile is the precompile stub
+// code, and the one of the contract of a blocked call is the revert code.
+func (h *evmHook) GetCode(addr common.Address) []byte {
+	if h.blockedContract != nil && *h.blockedContract == addr {
+		h.blockedContract = nil
+		return revertCode
+	}
+
+	if _, found := h.precompiles[addr]; found {
+		return types.PrecompileStubCode
+	}
+	return h.Keeper.GetCode(addr)
+}
+
+// Exist implements vm.StateDB. The enabled stateful precompiles exist.
+func (h *evmHook) Exist(addr common.Address) bool {
+	if _, found := h.precompiles[addr]; found {
+		return true
+	}
+	return h.Keeper.Exist(addr)
+}
+
+// Empty implements vm.StateDB. The enabled stateful precompiles are not empty.
+func (h *evmHook) Empty(addr common.Address) bool {
+	if _, found := h.precompiles[addr]; found {
+		return false
+	}
+	return h.Keeper.Empty(addr)
+}
+
+// CaptureStart implements vm.Tracer
+func (h *evmHook) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	h.static = []bool{false, false}
+
+	if h.tracer != nil {
+		h.tracer.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+// CaptureState implements vm.Tracer
+func (h *evmHook) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// the call blocked, if any, has failed or didn't reach the frame entry
+	h.blockedContract = nil
+
+	// the operation is traced before the hook updates the stack
+	if h.tracer != nil && !h.isStub(scope.Contract) {
+		h.tracer.CaptureState(env, pc, op, gas, cost, scope, rData, depth, err)
+	}
+
+	switch {
+	case err != nil:
+	case op == vm.CALL, op == vm.CALLCODE, op == vm.DELEGATECALL, op == vm.STATICCALL:
+		h.setStatic(depth+1, h.isStatic(depth) || op == vm.STATICCALL)
+		h.checkCall(scope)
+	case op == vm.CREATE, op == vm.CREATE2:
+		h.setStatic(depth+1, h.isStatic(depth))
+		h.checkDeployment(scope)
+	case op == vm.PUSH1 && pc == types.PrecompileHookPC:
+		h.run(env, scope, depth)
+	}
+}
+
+// CaptureFault implements vm.Tracer
+func (h *evmHook) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if h.tracer != nil && !h.isStub(scope.Contract) {
+		h.tracer.CaptureFault(env, pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+// CaptureEnd implements vm.Tracer
+func (h *evmHook) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	if h.tracer != nil {
+		h.tracer.CaptureEnd(output, gasUsed, d, err)
+	}
+}
+
+// isStub returns true if the given contract executes the stub code of a stateful precompile.
+func (h *evmHook) isStub(contract *vm.Contract) bool {
+	if contract == nil || contract.CodeAddr == nil {
+		return false
+	}
+
+	_, found := h.precompiles[*contract.CodeAddr]
+	return found
+}
+
+// checkCall blocks the call operation of the given scope if its caller is blocked. The address is
+// the second operand of the call operations.
+func (h *evmHook) checkCall(scope *vm.ScopeContext) {
+	caller, contract := scope.Contract.Address(), common.Address(scope.Stack.Back(1).Bytes20())
+	if h.permissions != nil && !h.permissions.checkCall(caller, contract) {
+		h.blockedContract = &contract
+	}
+}
+
+// checkDeployment blocks the create operation of the given scope if its deployer isn't allowed. The
+// value is the first operand of the create operations.
+func (h *evmHook) checkDeployment(scope *vm.ScopeContext) {
+	if h.permissions != nil && !h.permissions.checkDeployment(scope.Contract.Address()) {
+		scope.Stack.Back(0).SetAllOne()
+	}
+}
+
+func (h *evmHook) isStatic(depth int) bool {
+	return depth < len(h.static) && h.static[depth]
+}
+
+func (h *evmHook) setStatic(depth int, static bool) {
+	for len(h.static) <= depth {
+		h.static = append(h.static, false)
+	}
+	h.static[depth] = static
+}
+
+// run executes the stateful precompiled contract called from the given scope, if any, and sets the
+// output and the success flag expected by the stub code on the memory and the stack.
+func (h *evmHook) run(evm *vm.EVM, scope *vm.ScopeContext, depth int) {
+	contract := scope.Contract
+	if contract.CodeAddr == nil || len(scope.Stack.Data()) != 3 {
+		return
+	}
+
+	precompile, found := h.precompiles[*contract.CodeAddr]
+	if !found {
+		return
+	}
+
+	if !contract.UseGas(precompile.RequiredGas(h.Ctx(), contract.Input)) {
+		// the stub code runs out of gas on the next operation
+		contract.Gas = 0
+		return
+	}
+
+	var (
+		output []byte
+		err    error
+	)
+
+	if contract.Address() != precompile.Address() {
+		err = fmt.Errorf("precompile %s cannot be called with DELEGATECALL or CALLCODE", precompile.Address())
+	} else {
+		output, err = h.runPrecompile(precompile, evm, contract, h.isStatic(depth))
+	}
+
+	success := uint64(1)
+	if err != nil {
+		success = 0
+		if !errors.Is(err, vm.ErrExecutionReverted) {
+			output = revertReason(err.Error())
+		}
+	}
+
+	size := uint64(len(output))
+	if size > 0 {
+		// the memory is resized to a multiple of the word size so that no memory expansion is
+		// charged when returning the output
+		scope.Memory.Resize((size + 31) / 32 * 32)
+		scope.Memory.Set(0, size, output)
+	}
+
+	scope.Stack.Back(0).SetUint64(success)
+	scope.Stack.Back(1).SetUint64(0)
+	scope.Stack.Back(2).SetUint64(size)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/palantir/stacktrace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// GetBaseFee returns the EIP-1559 base fee of the current block, as calculated by the fee market
+// on the previous block. It returns nil if the base fee is disabled by the NoBaseFee parameter of
+// the fee market, before the first block following the EnableHeight of the fee market, for which
+// the initial base fee is calculated, or if it hasn't been calculated yet.
+func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
+	if k.feeMarketKeeper == nil {
+		return nil
+	}
+
+	if params := k.feeMarketKeeper.GetParams(ctx); params.NoBaseFee || ctx.BlockHeight() <= params.EnableHeight {
+		return nil
+	}
+
+	return k.feeMarketKeeper.GetBaseFee(ctx)
+}
+
+// HandleBaseFee transfers the base fee part of the fees paid for the gas used by a transaction, i.e.
+// gasUsed * baseFee, from the fee collector module account, which escrows the transaction fees, to
+// the destination defined by the BaseFeeDestination parameter of the fee market. The base fee is
+// either burned, kept by the fee collector or sent to the community pool. The remaining priority
+// fees are kept by the fee collector and distributed to the validators.
+func (k Keeper) HandleBaseFee(ctx sdk.Context, baseFee *big.Int, gasUsed uint64) error {
+	if baseFee == nil || baseFee.Sign() <= 0 || gasUsed == 0 {
+		return nil
+	}
+
+	amount := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+	coins := sdk.Coins{sdk.NewCoin(k.GetParams(ctx).EvmDenom, sdk.NewIntFromBigInt(amount))}
+
+	switch destination := k.feeMarketKeeper.GetParams(ctx).BaseFeeDestination; destination {
+	case feemarkettypes.BASE_FEE_DESTINATION_BURN:
+		// the fee collector can't burn coins, so they are burned by the evm module account
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "fee collector account failed to pay the base fee: %s", err.Error())
+			return stacktrace.Propagate(err, "failed to burn the base fee %s", coins)
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return stacktrace.Propagate(err, "failed to burn the base fee %s", coins)
+		}
+	case feemarkettypes.BASE_FEE_DESTINATION_FEE_COLLECTOR:
+		// the fees are already escrowed by the fee collector
+	case feemarkettypes.BASE_FEE_DESTINATION_COMMUNITY_POOL:
+		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, feeCollector); err != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "fee collector account failed to pay the base fee: %s", err.Error())
+			return stacktrace.Propagate(err, "failed to fund the community pool with the base fee %s", coins)
+		}
+	default:
+		return stacktrace.Propagate(
+			sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid base fee destination %s", destination),
+			"failed to handle the base fee %s", coins,
+		)
+	}
+
+	return nil
+}
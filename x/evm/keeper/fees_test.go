@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestGetBaseFee() {
+	testCases := []struct {
+		name         string
+		noBaseFee    bool
+		enableHeight int64
+		baseFee      *big.Int
+		expBaseFee   *big.Int
+	}{
+		{"base fee not enforced", true, 0, big.NewInt(10), nil},
+		{"base fee not enabled yet", false, suite.ctx.BlockHeight(), big.NewInt(10), nil},
+		{"base fee not calculated", false, 0, nil, nil},
+		{"base fee enforced", false, 0, big.NewInt(10), big.NewInt(10)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.NoBaseFee = tc.noBaseFee
+			params.EnableHeight = tc.enableHeight
+			suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			if tc.baseFee != nil {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, tc.baseFee)
+			}
+
+			suite.Require().Equal(tc.expBaseFee, suite.app.EvmKeeper.GetBaseFee(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleBaseFee() {
+	testCases := []struct {
+		name              string
+		destination       feemarkettypes.BaseFeeDestination
+		expFeeCollector   func(gasUsed sdk.Int) sdk.Int
+		expCommunityPool  func(gasUsed sdk.Int) sdk.Int
+		expSupplyDecrease func(gasUsed sdk.Int) sdk.Int
+	}{
+		{
+			"burn",
+			feemarkettypes.BASE_FEE_DESTINATION_BURN,
+			func(gasUsed sdk.Int) sdk.Int { return gasUsed },
+			func(sdk.Int) sdk.Int { return sdk.ZeroInt() },
+			func(gasUsed sdk.Int) sdk.Int { return gasUsed },
+		},
+		{
+			"fee collector",
+			feemarkettypes.BASE_FEE_DESTINATION_FEE_COLLECTOR,
+			func(gasUsed sdk.Int) sdk.Int { return gasUsed.MulRaw(2) },
+			func(sdk.Int) sdk.Int { return sdk.ZeroInt() },
+			func(sdk.Int) sdk.Int { return sdk.ZeroInt() },
+		},
+		{
+			"community pool",
+			feemarkettypes.BASE_FEE_DESTINATION_COMMUNITY_POOL,
+			func(gasUsed sdk.Int) sdk.Int { return gasUsed },
+			func(gasUsed sdk.Int) sdk.Int { return gasUsed },
+			func(sdk.Int) sdk.Int { return sdk.ZeroInt() },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.NoBaseFee = false
+			params.EnableHeight = 0
+			params.BaseFeeDestination = tc.destination
+			suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1))
+
+			// fees paid to the fee collector by the ante handler, at a gas price of 2
+			fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(200000)))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, fees))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, evmtypes.DefaultEVMDenom)
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(evmtypes.DefaultEVMDenom)
+
+			chainID := suite.app.EvmKeeper.ChainID()
+			tx := evmtypes.NewTx(chainID, 0, &common.Address{}, nil, 100000, big.NewInt(2), nil, nil)
+			tx.From = suite.address.Hex()
+			suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			suite.Require().NoError(err)
+			suite.Require().False(rsp.Failed(), rsp.VmError)
+
+			// the leftover gas is refunded and the base fee of the gas used is transferred to its
+			// destination, the fee collector keeps the priority fees
+			gasUsed := sdk.NewIntFromUint64(rsp.GasUsed)
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			suite.Require().Equal(tc.expFeeCollector(gasUsed), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, evmtypes.DefaultEVMDenom).Amount)
+			suite.Require().Equal(
+				communityPool.Add(tc.expCommunityPool(gasUsed).ToDec()),
+				suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(evmtypes.DefaultEVMDenom),
+			)
+			suite.Require().Equal(supply.Amount.Sub(tc.expSupplyDecrease(gasUsed)), suite.app.BankKeeper.GetSupply(suite.ctx, evmtypes.DefaultEVMDenom).Amount)
+		})
+	}
+}
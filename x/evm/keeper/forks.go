@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// ScheduleFork schedules the activation of the given hard fork of the chain config, if any, and of
+// the extra EIPs at a future block height. The hard fork must not be activated yet, and it must
+// be activated after the previous hard forks and before the next ones.
+func (k Keeper) ScheduleFork(ctx sdk.Context, fork string, height uint64, extraEIPs []int64) error {
+	if height <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidForkSchedule, "activation height %d must be greater than the current height %d", height, ctx.BlockHeight(),
+		)
+	}
+
+	params := k.GetParams(ctx)
+
+	if fork != "" {
+		block, err := params.ChainConfig.ForkBlock(fork)
+		if err != nil {
+			return err
+		}
+
+		if block != nil && block.LTE(sdk.NewInt(ctx.BlockHeight())) {
+			return sdkerrors.Wrapf(types.ErrInvalidForkSchedule, "hard fork %s already activated at height %s", fork, block)
+		}
+
+		params.ChainConfig, err = params.ChainConfig.ScheduleFork(fork, height)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidForkSchedule, err.Error())
+		}
+	}
+
+	if len(extraEIPs) > 0 {
+		if err := types.ValidateScheduledEIPs(extraEIPs); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidForkSchedule, err.Error())
+		}
+
+		scheduled := make(map[int64]uint64)
+		for _, eip := range params.ExtraEIPs {
+			scheduled[eip] = 0
+		}
+		k.IterateScheduledEIPs(ctx, func(activation types.ForkActivation) bool {
+			for _, eip := range activation.ExtraEIPs {
+				scheduled[eip] = activation.Height
+			}
+			return false
+		})
+
+		for _, eip := range extraEIPs {
+			if activationHeight, found := scheduled[eip]; found {
+				return sdkerrors.Wrapf(types.ErrInvalidForkSchedule, "EIP %d already scheduled at height %d", eip, activationHeight)
+			}
+		}
+
+		activation, _ := k.GetScheduledEIPs(ctx, height)
+		activation.Height = height
+		activation.ExtraEIPs = append(activation.ExtraEIPs, extraEIPs...)
+		k.SetScheduledEIPs(ctx, activation)
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// ActivateForks enables the extra EIPs scheduled at the current block height, and emits an event
+// if hard forks of the chain config or extra EIPs are activated at the current block height.
+func (k Keeper) ActivateForks(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	params := k.GetParams(ctx)
+
+	forks := params.ChainConfig.ForksActivatedAt(height)
+
+	activation, found := k.GetScheduledEIPs(ctx, height)
+	if found {
+		params.ExtraEIPs = append(params.ExtraEIPs, activation.ExtraEIPs...)
+		k.SetParams(ctx, params)
+		k.DeleteScheduledEIPs(ctx, height)
+	}
+
+	if len(forks) == 0 && !found {
+		return
+	}
+
+	eips := make([]string, len(activation.ExtraEIPs))
+	for i, eip := range activation.ExtraEIPs {
+		eips[i] = strconv.FormatInt(eip, 10)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForkActivation,
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyForks, strings.Join(forks, ",")),
+			sdk.NewAttribute(types.AttributeKeyExtraEIPs, strings.Join(eips, ",")),
+		),
+	)
+
+	k.Logger(ctx).Info("hard fork activated", "height", height, "forks", forks, "extra-eips", activation.ExtraEIPs)
+}
+
+// GetUpcomingForks returns the hard forks of the chain config and the extra EIPs activated after
+// the current block height, sorted by activation height.
+func (k Keeper) GetUpcomingForks(ctx sdk.Context) []types.ForkActivation {
+	current := uint64(ctx.BlockHeight())
+	params := k.GetParams(ctx)
+
+	activations := make(map[uint64]*types.ForkActivation)
+	getActivation := func(height uint64) *types.ForkActivation {
+		if _, found := activations[height]; !found {
+			activations[height] = &types.ForkActivation{Height: height, Forks: []string{}, ExtraEIPs: []int64{}}
+		}
+		return activations[height]
+	}
+
+	for _, fork := range types.Forks {
+		block, _ := params.ChainConfig.ForkBlock(fork)
+		if block == nil || !block.BigInt().IsUint64() || block.Uint64() <= current {
+			continue
+		}
+
+		activation := getActivation(block.Uint64())
+		activation.Forks = append(activation.Forks, fork)
+	}
+
+	k.IterateScheduledEIPs(ctx, func(scheduled types.ForkActivation) bool {
+		if scheduled.Height > current {
+			activation := getActivation(scheduled.Height)
+			activation.ExtraEIPs = append(activation.ExtraEIPs, scheduled.ExtraEIPs...)
+		}
+		return false
+	})
+
+	upcoming := make([]types.ForkActivation, 0, len(activations))
+	for _, activation := range activations {
+		upcoming = append(upcoming, *activation)
+	}
+
+	sort.Slice(upcoming, func(i, j int) bool {
+		return upcoming[i].Height < upcoming[j].Height
+	})
+
+	return upcoming
+}
+
+// GetScheduledEIPs returns the extra EIPs scheduled at the given block height.
+func (k Keeper) GetScheduledEIPs(ctx sdk.Context, height uint64) (types.ForkActivation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledEIPs)
+	bz := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(bz) == 0 {
+		return types.ForkActivation{}, false
+	}
+
+	var activation types.ForkActivation
+	k.cdc.MustUnmarshal(bz, &activation)
+	return activation, true
+}
+
+// SetScheduledEIPs stores the extra EIPs scheduled at the block height of the activation.
+func (k Keeper) SetScheduledEIPs(ctx sdk.Context, activation types.ForkActivation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledEIPs)
+	store.Set(sdk.Uint64ToBigEndian(activation.Height), k.cdc.MustMarshal(&activation))
+}
+
+// DeleteScheduledEIPs removes the extra EIPs scheduled at the given block height.
+func (k Keeper) DeleteScheduledEIPs(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledEIPs)
+	store.Delete(sdk.Uint64ToBigEndian(height))
+}
+
+// IterateScheduledEIPs iterates over the scheduled extra EIPs by increasing activation height,
+// until the callback returns true.
+func (k Keeper) IterateScheduledEIPs(ctx sdk.Context, cb func(activation types.ForkActivation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledEIPs)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var activation types.ForkActivation
+		k.cdc.MustUnmarshal(iterator.Value(), &activation)
+
+		if cb(activation) {
+			break
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// disableBerlin disables the berlin and london hard forks of the chain config.
+func (suite *KeeperTestSuite) disableBerlin() {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ChainConfig.BerlinBlock = nil
+	params.ChainConfig.LondonBlock = nil
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestScheduleFork() {
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		fork      string
+		height    uint64
+		extraEIPs []int64
+		expPass   bool
+	}{
+		{
+			"past height",
+			suite.disableBerlin,
+			types.ForkBerlin, 1, nil, false,
+		},
+		{
+			"unknown fork",
+			func() {},
+			"shanghai", 10, nil, false,
+		},
+		{
+			"fork already activated",
+			func() {},
+			types.ForkBerlin, 10, nil, false,
+		},
+		{
+			"fork out of order",
+			suite.disableBerlin,
+			types.ForkLondon, 10, nil, false,
+		},
+		{
+			"fork after the next fork",
+			func() {
+				suite.disableBerlin()
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.ChainConfig, _ = params.ChainConfig.ScheduleFork(types.ForkBerlin, 20)
+				params.ChainConfig, _ = params.ChainConfig.ScheduleFork(types.ForkLondon, 20)
+				suite.app.EvmKeeper.SetParams(suite.ctx, params)
+			},
+			types.ForkBerlin, 30, nil, false,
+		},
+		{
+			"EIP already enabled",
+			func() {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.ExtraEIPs = []int64{1344}
+				suite.app.EvmKeeper.SetParams(suite.ctx, params)
+			},
+			"", 10, []int64{1344}, false,
+		},
+		{
+			"EIP already scheduled",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.ScheduleFork(suite.ctx, "", 20, []int64{1344}))
+			},
+			"", 10, []int64{1344}, false,
+		},
+		{
+			"invalid EIP",
+			func() {},
+			"", 10, []int64{1}, false,
+		},
+		{
+			"fork scheduled",
+			suite.disableBerlin,
+			types.ForkBerlin, 10, []int64{1344}, true,
+		},
+		{
+			"fork rescheduled",
+			func() {
+				suite.disableBerlin()
+				suite.Require().NoError(suite.app.EvmKeeper.ScheduleFork(suite.ctx, types.ForkBerlin, 20, nil))
+			},
+			types.ForkBerlin, 10, []int64{1344}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := suite.app.EvmKeeper.ScheduleFork(suite.ctx, tc.fork, tc.height, tc.extraEIPs)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			block, err := params.ChainConfig.ForkBlock(tc.fork)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewIntFromUint64(tc.height), *block)
+
+			activation, found := suite.app.EvmKeeper.GetScheduledEIPs(suite.ctx, tc.height)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.extraEIPs, activation.ExtraEIPs)
+			suite.Require().NotContains(params.ExtraEIPs, tc.extraEIPs[0])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestActivateForks() {
+	suite.disableBerlin()
+	k := suite.app.EvmKeeper
+	suite.Require().NoError(k.ScheduleFork(suite.ctx, types.ForkBerlin, 10, []int64{1344}))
+	suite.Require().NoError(k.ScheduleFork(suite.ctx, types.ForkLondon, 20, nil))
+	suite.Require().NoError(k.ScheduleFork(suite.ctx, "", 30, []int64{2200}))
+
+	suite.Require().Equal([]types.ForkActivation{
+		{Height: 10, Forks: []string{types.ForkBerlin}, ExtraEIPs: []int64{1344}},
+		{Height: 20, Forks: []string{types.ForkLondon}, ExtraEIPs: []int64{}},
+		{Height: 30, Forks: []string{}, ExtraEIPs: []int64{2200}},
+	}, k.GetUpcomingForks(suite.ctx))
+
+	for height := int64(2); height <= 30; height++ {
+		ctx := suite.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		k.ActivateForks(ctx)
+
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeForkActivation {
+				events = append(events, event)
+			}
+		}
+
+		switch height {
+		case 10:
+			suite.Require().Len(events, 1)
+			suite.Require().Equal(
+				sdk.NewEvent(
+					types.EventTypeForkActivation,
+					sdk.NewAttribute(types.AttributeKeyHeight, "10"),
+					sdk.NewAttribute(types.AttributeKeyForks, types.ForkBerlin),
+					sdk.NewAttribute(types.AttributeKeyExtraEIPs, "1344"),
+				),
+				events[0],
+			)
+			suite.Require().Equal([]int64{1344}, k.GetParams(ctx).ExtraEIPs)
+		case 20, 30:
+			suite.Require().Len(events, 1)
+		default:
+			suite.Require().Empty(events)
+		}
+	}
+
+	suite.Require().Equal([]int64{1344, 2200}, k.GetParams(suite.ctx).ExtraEIPs)
+	suite.Require().Empty(k.GetUpcomingForks(suite.ctx.WithBlockHeight(30)))
+	k.IterateScheduledEIPs(suite.ctx, func(activation types.ForkActivation) bool {
+		suite.Fail("scheduled EIPs not removed", activation.Height)
+		return true
+	})
+}
@@ -21,9 +21,11 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	ethermint "github.com/tharsis/ethermint/types"
+	"github.com/tharsis/ethermint/x/evm/tracers/native"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
 
@@ -206,6 +208,43 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
+// DeploymentAllowlist implements the Query/DeploymentAllowlist gRPC method
+func (k Keeper) DeploymentAllowlist(c context.Context, _ *types.QueryDeploymentAllowlistRequest) (*types.QueryDeploymentAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryDeploymentAllowlistResponse{
+		AllowedDeployers: params.AllowedDeployers,
+	}, nil
+}
+
+// CallBlocklist implements the Query/CallBlocklist gRPC method
+func (k Keeper) CallBlocklist(c context.Context, req *types.QueryCallBlocklistRequest) (*types.QueryCallBlocklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryCallBlocklistResponse{
+		BlockedCallers: params.GetBlockedCallers(common.HexToAddress(req.Contract)),
+	}, nil
+}
+
+// UpcomingForks implements the Query/UpcomingForks gRPC method
+func (k Keeper) UpcomingForks(c context.Context, _ *types.QueryUpcomingForksRequest) (*types.QueryUpcomingForksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryUpcomingForksResponse{
+		Activations: k.GetUpcomingForks(ctx),
+	}, nil
+}
+
 // EthCall implements eth_call rpc api.
 func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.MsgEthereumTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -217,6 +256,15 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	overrides, err := decodeStateOverrides(req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := k.withStateOverrides(ctx, overrides); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	msg := args.ToMessage(req.GasCap)
 
 	params := k.GetParams(ctx)
@@ -255,6 +303,11 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	overrides, err := decodeStateOverrides(req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo  = ethparams.TxGas - 1
@@ -296,7 +349,9 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		args.Gas = (*hexutil.Uint64)(&gas)
 
 		// Reset to the initial context
-		k.WithContext(ctx)
+		if err := k.withStateOverrides(ctx, overrides); err != nil {
+			return true, nil, err // Bail out
+		}
 
 		msg := args.ToMessage(req.GasCap)
 
@@ -342,11 +397,117 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api. The call is executed repeatedly with
+// an access list tracer, each time with the access list collected by the previous execution, until
+// the collected access list doesn't change anymore.
+// NOTE: the procedure matches the one from go-ethereum. For the canonical code refer to:
+// https://github.com/ethereum/go-ethereum/blob/v1.10.3/internal/ethapi/api.go
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.WithContext(ctx)
+
+	var args types.CallArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	overrides, err := decodeStateOverrides(req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+
+	coinbase, err := k.GetCoinbaseAddress(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Each execution runs on a new branch of the state, so that the access list
+	// and the refund of the previous one stored on the transient store are discarded
+	resetState := func() error {
+		cacheCtx, _ := ctx.CacheContext()
+		return k.withStateOverrides(cacheCtx, overrides)
+	}
+
+	if err := resetState(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+
+	// The recipient of a contract creation is the address of the created contract
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, k.GetNonce(from))
+	}
+
+	// Retrieve the precompiles since they don't need to be added to the access list
+	precompiles := k.ActivePrecompiles(ethCfg.Rules(big.NewInt(ctx.BlockHeight())), params)
+
+	// Create an initial tracer
+	prevTracer := vm.NewAccessListTracer(nil, from, to, precompiles)
+	if args.AccessList != nil {
+		prevTracer = vm.NewAccessListTracer(*args.AccessList, from, to, precompiles)
+	}
+
+	k.enableTracing()
+
+	for {
+		// Retrieve the current access list to expand
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		if err := resetState(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		msg := args.ToMessage(req.GasCap)
+
+		// Apply the transaction with the access list tracer
+		tracer := vm.NewAccessListTracer(accessList, from, to, precompiles)
+		evm := k.NewEVM(msg, ethCfg, params, coinbase, tracer)
+
+		// pass true means execute in query mode, which don't do actual gas refund.
+		res, err := k.ApplyMessage(evm, msg, ethCfg, true)
+		k.ctxStack.RevertAll()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to apply transaction: %s", err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
-// be tracer dependent.
+// be tracer dependent. The predecessors of the message in its block are replayed
+// first, without tracing, so that the message is traced on the same state it was
+// executed on. Only the AnteHandler state transitions of the failed predecessors are
+// replayed.
 func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*types.QueryTraceTxResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := traceContext(sdk.UnwrapSDKContext(c), req.BlockNumber, req.BlockTime, req.ProposerAddress)
 	k.WithContext(ctx)
 	params := k.GetParams(ctx)
 
@@ -357,7 +518,30 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 
 	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
 	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
-	result, err := k.traceTx(c, coinbase, signer, req.TxIndex, params, ctx, ethCfg, req.Msg, req.TraceConfig)
+
+	for i := 0; i < len(req.Predecessors); i++ {
+		tx := req.Predecessors[i]
+		if end := failedTxsEnd(req.Predecessors, req.FailedTxs, i); end > i {
+			if err := k.replayAnte(ctx, req.Predecessors[i:end], req.FeeGranters, params, ethCfg); err != nil {
+				k.Logger(ctx).Debug("failed to replay failed predecessors", "hash", tx.Hash, "index", i, "error", err.Error())
+			}
+			i = end - 1
+			continue
+		}
+
+		feeGranter, err := txFeeGranter(req.FeeGranters, tx.Hash)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if err := k.replayTx(ctx, tx, feeGranter, params, ethCfg); err != nil {
+			k.Logger(ctx).Debug("failed to replay predecessor", "hash", tx.Hash, "index", i, "error", err.Error())
+		}
+	}
+
+	k.enableTracing()
+
+	result, err := k.traceTx(c, coinbase, signer, req.TxIndex, params, k.Ctx(), ethCfg, req.Msg, req.TraceConfig)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -372,19 +556,332 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on the state of the queried block, with the optional
+// state overrides applied. The state changes are not committed. The return value
+// will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.WithContext(ctx)
+
+	var args types.CallArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	overrides, err := decodeStateOverrides(req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := k.withStateOverrides(ctx, overrides); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	msg := args.ToMessage(req.GasCap)
+
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+
+	coinbase, err := k.GetCoinbaseAddress(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	k.enableTracing()
+
+	result, err := k.traceMsg(c, coinbase, params, k.Ctx(), ethCfg, msg, req.TraceConfig)
+	k.ctxStack.RevertAll()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
+// TraceBlock configures a new tracer according to the provided configuration, and
+// executes the given messages sequentially on the state of the block. Each message
+// is traced on the state left by the previous ones. The return value is a list of
+// tracer dependent results, one for each message that didn't fail in the block. Only
+// the AnteHandler state transitions of the failed messages are replayed.
+func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest) (*types.QueryTraceBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := traceContext(sdk.UnwrapSDKContext(c), req.BlockNumber, req.BlockTime, req.ProposerAddress)
+	k.WithContext(ctx)
+	params := k.GetParams(ctx)
+
+	coinbase, err := k.GetCoinbaseAddress(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+
+	k.enableTracing()
+
+	results := make([]*types.TxTraceResult, 0, len(req.Txs))
+
+	for i := 0; i < len(req.Txs); i++ {
+		tx := req.Txs[i]
+		if end := failedTxsEnd(req.Txs, req.FailedTxs, i); end > i {
+			// the failed transactions are not traced, only their AnteHandler state
+			// transitions are replayed
+			if err := k.replayAnte(ctx, req.Txs[i:end], req.FeeGranters, params, ethCfg); err != nil {
+				k.Logger(ctx).Debug("failed to replay failed transactions", "hash", tx.Hash, "index", i, "error", err.Error())
+			}
+			i = end - 1
+			continue
+		}
+
+		feeGranter, err := txFeeGranter(req.FeeGranters, tx.Hash)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// trace the message on a branch of the state, as the tracing doesn't
+		// perform the AnteHandler state transitions
+		cacheCtx, _ := ctx.CacheContext()
+		k.WithContext(cacheCtx)
+
+		result, err := k.traceTx(c, coinbase, signer, uint64(len(results)), params, cacheCtx, ethCfg, tx, req.TraceConfig)
+		if err != nil {
+			results = append(results, &types.TxTraceResult{Error: err.Error()})
+		} else {
+			results = append(results, &types.TxTraceResult{Result: result})
+		}
+
+		// then replay it on the block state so that the next message is traced on top of it
+		if err := k.replayTx(ctx, tx, feeGranter, params, ethCfg); err != nil {
+			k.Logger(ctx).Debug("failed to replay transaction", "hash", tx.Hash, "index", i, "error", err.Error())
+		}
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceBlockResponse{
+		Data: resultData,
+	}, nil
+}
+
+// txFeeGranter returns the fee granter of the transaction with the given hash from the fee granters
+// of a trace request, or nil if the transaction fees are paid by its sender.
+func txFeeGranter(feeGranters map[string]string, hash string) (sdk.AccAddress, error) {
+	feeGranter, ok := feeGranters[hash]
+	if !ok {
+		return nil, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(feeGranter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee granter %s of transaction %s: %w", feeGranter, hash, err)
+	}
+	return addr, nil
+}
+
+// replayTx applies the given transaction on the provided context without tracing it. The fee
+// deduction and the sender nonce increment performed by the AnteHandler are applied as well, so
+// that the resulting state matches the one after the transaction execution in the block. The fees
+// of a transaction with a fee granter are deducted from the granter, which is refunded the leftover
+// gas, as in the AnteHandler. Its fee allowance isn't used, as it isn't part of the EVM state. The
+// state changes are only written if the transaction is successfully applied. The keeper context
+// is set to the provided one afterwards.
+func (k *Keeper) replayTx(ctx sdk.Context, msg *types.MsgEthereumTx, feeGranter sdk.AccAddress, params types.Params, ethCfg *ethparams.ChainConfig) error {
+	debug := k.debug
+	defer func() {
+		k.debug = debug
+		k.WithContext(ctx)
+	}()
+
+	// replayed transactions are not traced
+	k.debug = false
+
+	cacheCtx, write := ctx.CacheContext()
+	k.WithContext(cacheCtx)
+
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to unpack tx data")
+	}
+
+	k.ResetRefundTransient(cacheCtx)
+
+	feePayer := msg.GetFrom()
+	if feeGranter != nil {
+		feePayer = feeGranter
+		k.SetFeePayerTransient(cacheCtx, msg.AsTransaction().Hash(), feeGranter)
+	}
+
+	if txData.Fee().Sign() > 0 {
+		height := big.NewInt(ctx.BlockHeight())
+		if _, err := DeductTxCostsFromFeePayer(
+			cacheCtx, k.bankKeeper, k.accountKeeper, feePayer, *msg, txData, params.EvmDenom, k.GetBaseFee(cacheCtx),
+			ethCfg.IsHomestead(height), ethCfg.IsIstanbul(height),
+		); err != nil {
+			return err
+		}
+	}
+
+	// on contract creation, the nonce is incremented within the EVM Create function
+	if txData.GetTo() != nil {
+		from := common.BytesToAddress(msg.GetFrom())
+		k.SetNonce(from, k.GetNonce(from)+1)
+	}
+
+	if _, err := k.ApplyTransaction(msg.AsTransaction()); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// failedTxsEnd returns the end index of the transactions of the same failed cosmos transaction as
+// the transaction at the given index, or the index itself if the transaction didn't fail.
+func failedTxsEnd(txs []*types.MsgEthereumTx, failedTxs map[string]uint64, i int) int {
+	txIndex, failed := failedTxs[txs[i].Hash]
+	if !failed {
+		return i
+	}
+
+	end := i + 1
+	for ; end < len(txs); end++ {
+		if index, found := failedTxs[txs[end].Hash]; !found || index != txIndex {
+			break
+		}
+	}
+	return end
+}
+
+// replayAnte applies the AnteHandler state transitions of the given transactions of a cosmos
+// transaction whose execution failed on the provided context, as they are committed even though
+// the execution is reverted: the fees are deducted from the fee payers and the nonces of the
+// senders are incremented, except on contract creation. As in the AnteHandler, the state changes
+// are only written if the nonces of all the transactions are valid and their fees are covered, and
+// the fee allowances aren't used. The keeper context is set to the provided one afterwards.
+func (k *Keeper) replayAnte(ctx sdk.Context, msgs []*types.MsgEthereumTx, feeGranters map[string]string, params types.Params, ethCfg *ethparams.ChainConfig) error {
+	defer k.WithContext(ctx)
+
+	cacheCtx, write := ctx.CacheContext()
+	k.WithContext(cacheCtx)
+
+	height := big.NewInt(ctx.BlockHeight())
+	// next expected nonce of the senders of the previous messages
+	nonces := make(map[common.Address]uint64)
+
+	for _, msg := range msgs {
+		txData, err := types.UnpackTxData(msg.Data)
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to unpack tx data")
+		}
+
+		feeGranter, err := txFeeGranter(feeGranters, msg.Hash)
+		if err != nil {
+			return err
+		}
+
+		from := common.BytesToAddress(msg.GetFrom())
+		nonce, found := nonces[from]
+		if !found {
+			nonce = k.GetNonce(from)
+		}
+		if txData.GetNonce() != nonce {
+			return fmt.Errorf("invalid nonce %d of transaction %s, expected %d", txData.GetNonce(), msg.Hash, nonce)
+		}
+		nonces[from] = nonce + 1
+
+		feePayer := msg.GetFrom()
+		if feeGranter != nil {
+			feePayer = feeGranter
+		}
+
+		if txData.Fee().Sign() > 0 {
+			if _, err := DeductTxCostsFromFeePayer(
+				cacheCtx, k.bankKeeper, k.accountKeeper, feePayer, *msg, txData, params.EvmDenom, k.GetBaseFee(cacheCtx),
+				ethCfg.IsHomestead(height), ethCfg.IsIstanbul(height),
+			); err != nil {
+				return err
+			}
+		}
+
+		// on contract creation, the nonce is incremented within the EVM Create function, which
+		// is reverted
+		if txData.GetTo() != nil {
+			k.SetNonce(from, k.GetNonce(from)+1)
+		}
+	}
+
+	write()
+	return nil
+}
+
+// enableTracing sets the debug mode on the keeper, so that the EVM calls the tracer of
+// the messages executed by a query. The keeper is a copy within the gRPC queries, so
+// the state transitions executed in the blocks are not affected.
+func (k *Keeper) enableTracing() {
+	k.debug = true
+}
+
+// traceContext returns the context to trace the transactions of a block on, with the number, time
+// and proposer of the block. The traces are executed on the state of the parent block, whose
+// header is kept if the block number is zero.
+func traceContext(ctx sdk.Context, blockNumber int64, blockTime time.Time, proposer sdk.ConsAddress) sdk.Context {
+	if blockNumber == 0 {
+		return ctx
+	}
+
+	header := ctx.BlockHeader()
+	header.Height = blockNumber
+	header.Time = blockTime
+	if len(proposer) > 0 {
+		header.ProposerAddress = proposer
+	}
+
+	return ctx.WithBlockHeader(header)
+}
+
+// traceTx traces the given Ethereum transaction message, setting its hash and index in the
+// block to the transient store beforehand.
 func (k *Keeper) traceTx(c context.Context, coinbase common.Address, signer ethtypes.Signer, txIndex uint64,
 	params types.Params, ctx sdk.Context, ethCfg *ethparams.ChainConfig, msg *types.MsgEthereumTx, traceConfig *types.TraceConfig) (*interface{}, error) {
+	coreMessage, err := msg.AsMessage(signer, k.GetBaseFee(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	k.SetTxHashTransient(common.HexToHash(msg.Hash))
+	k.SetTxIndexTransient(txIndex)
+
+	return k.traceMsg(c, coinbase, params, ctx, ethCfg, coreMessage, traceConfig)
+}
+
+// traceMsg executes the given message on the current state with a tracer assembled from the
+// provided configuration, and returns the tracer dependent result.
+func (k *Keeper) traceMsg(c context.Context, coinbase common.Address, params types.Params, ctx sdk.Context,
+	ethCfg *ethparams.ChainConfig, coreMessage core.Message, traceConfig *types.TraceConfig) (*interface{}, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
 		tracer vm.Tracer
 		err    error
 	)
 
-	coreMessage, err := msg.AsMessage(signer)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
 	switch {
 	case traceConfig != nil && traceConfig.Tracer != "":
 		timeout := defaultTraceTimeout
@@ -396,18 +893,28 @@ func (k *Keeper) traceTx(c context.Context, coinbase common.Address, signer etht
 			}
 		}
 
-		txContext := core.NewEVMTxContext(coreMessage)
-		// Construct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(traceConfig.Tracer, txContext); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+		// Construct the native tracer registered under the given name if any, or
+		// the JavaScript tracer to execute with otherwise
+		precompiles := k.ActivePrecompiles(ethCfg.Rules(big.NewInt(ctx.BlockHeight())), params)
+		t, ok := native.New(traceConfig.Tracer, precompiles)
+		if !ok {
+			tracerCtx := &tracers.Context{
+				BlockHash: common.BytesToHash(ctx.HeaderHash()),
+				TxIndex:   int(k.GetTxIndexTransient()),
+				TxHash:    k.GetTxHashTransient(),
+			}
+			if t, err = tracers.New(traceConfig.Tracer, tracerCtx); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
 		}
+		tracer = t
 
 		// Handle timeouts and RPC cancellations
 		deadlineCtx, cancel := context.WithTimeout(c, timeout)
 		go func() {
 			<-deadlineCtx.Done()
 			if deadlineCtx.Err() == context.DeadlineExceeded {
-				tracer.(*tracers.Tracer).Stop(errors.New("execution timeout"))
+				t.Stop(errors.New("execution timeout"))
 			}
 		}()
 		defer cancel()
@@ -425,8 +932,7 @@ func (k *Keeper) traceTx(c context.Context, coinbase common.Address, signer etht
 
 	evm := k.NewEVM(coreMessage, ethCfg, params, coinbase, tracer)
 
-	k.SetTxHashTransient(common.HexToHash(msg.Hash))
-	k.SetTxIndexTransient(txIndex)
+	k.ResetRefundTransient(k.Ctx())
 
 	res, err := k.ApplyMessage(evm, coreMessage, ethCfg, true)
 	if err != nil {
@@ -444,7 +950,8 @@ func (k *Keeper) traceTx(c context.Context, coinbase common.Address, signer etht
 			ReturnValue: "",
 			StructLogs:  types.FormatLogs(tracer.StructLogs()),
 		}
-	case *tracers.Tracer:
+	case native.Tracer:
+		// both the native and the JavaScript tracers
 		result, err = tracer.GetResult()
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -456,3 +963,36 @@ func (k *Keeper) traceTx(c context.Context, coinbase common.Address, signer etht
 
 	return &result, nil
 }
+
+// decodeStateOverrides decodes and validates the json encoded state overrides of a
+// call request.
+func decodeStateOverrides(bz []byte) (types.StateOverride, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// withStateOverrides sets the given context on the keeper. If any state overrides
+// are provided, they are applied on a branch of the context so that they are
+// discarded once the call is executed.
+func (k *Keeper) withStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	if len(overrides) == 0 {
+		k.WithContext(ctx)
+		return nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	k.WithContext(cacheCtx)
+	return k.ApplyStateOverrides(overrides)
+}
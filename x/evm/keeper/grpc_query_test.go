@@ -4,13 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
 	ethermint "github.com/tharsis/ethermint/types"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
@@ -556,6 +562,71 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	var args []byte
+
+	testCases := []struct {
+		msg       string
+		malleate  func(contractAddr common.Address)
+		expPass   bool
+		expTuples int
+		expKeys   int
+		expGas    uint64
+	}{
+		{
+			"invalid args",
+			func(common.Address) {
+				args = []byte("invalid")
+			},
+			false, 0, 0, 0,
+		},
+		{
+			"transfer, no accesses",
+			func(common.Address) {
+				args, _ = json.Marshal(&types.CallArgs{To: &recipient, From: &suite.address})
+			},
+			true, 0, 0, 21000,
+		},
+		{
+			"erc20 transfer, balances of the sender and the recipient",
+			func(contractAddr common.Address) {
+				data, err := ContractABI.Pack("transfer", recipient, big.NewInt(1000))
+				suite.Require().NoError(err)
+				args, _ = json.Marshal(&types.CallArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+			},
+			true, 1, 2, 54080,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+
+			tc.malleate(contractAddr)
+
+			rsp, err := suite.queryClient.CreateAccessList(ctx, &types.EthCallRequest{Args: args, GasCap: 25_000_000})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Empty(rsp.VmError)
+			suite.Require().Len(rsp.AccessList, tc.expTuples)
+			for _, tuple := range rsp.AccessList {
+				suite.Require().Equal(contractAddr.Hex(), tuple.Address)
+				suite.Require().Len(tuple.StorageKeys, tc.expKeys)
+			}
+			suite.Require().Equal(tc.expGas, rsp.GasUsed)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceTx() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	// TODO deploy contract that triggers internal transactions
@@ -576,7 +647,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 				traceConfig = nil
 			},
 			expPass:       true,
-			traceResponse: []byte{0x7b, 0x22, 0x67, 0x61, 0x73, 0x22, 0x3a, 0x33, 0x34, 0x38, 0x32, 0x38, 0x2c, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x22, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x3a, 0x5b, 0x5d, 0x7d},
+			traceResponse: []byte(`{"gas":34828,"failed":false,"returnValue":"","structLogs":[{"pc":0,"op":"PUSH1","gas":`),
 		}, {
 			msg: "javascript tracer",
 			malleate: func() {
@@ -586,6 +657,27 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: []byte{0x5b, 0x5d},
+		}, {
+			msg: "native call tracer",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{Tracer: "callTracer"}
+			},
+			expPass:       true,
+			traceResponse: []byte(`{"type":"CALL","from":"0x`),
+		}, {
+			msg: "native 4byte tracer",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{Tracer: "4byteTracer"}
+			},
+			expPass:       true,
+			traceResponse: []byte(`{"0xa9059cbb-64":1}`),
+		}, {
+			msg: "native prestate tracer",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{Tracer: "prestateTracer"}
+			},
+			expPass:       true,
+			traceResponse: []byte(`{"0x`),
 		},
 	}
 
@@ -609,10 +701,618 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.traceResponse, res.Data)
+				suite.Require().GreaterOrEqual(len(res.Data), len(tc.traceResponse))
+				suite.Require().Equal(tc.traceResponse, res.Data[:len(tc.traceResponse)])
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// deliverOnBranch executes the ERC20 token transfers on a discarded branch of the
+// state and returns the signed messages, so that they can be traced on the current state.
+func (suite *KeeperTestSuite) deliverOnBranch(contractAddr, to common.Address, amounts ...*big.Int) []*types.MsgEthereumTx {
+	ctx := suite.ctx
+	suite.ctx, _ = ctx.CacheContext()
+	defer func() {
+		suite.ctx = ctx
+		suite.app.EvmKeeper.WithContext(ctx)
+	}()
+
+	msgs := make([]*types.MsgEthereumTx, len(amounts))
+	for i, amount := range amounts {
+		msgs[i] = suite.TransferERC20Token(suite.T(), contractAddr, suite.address, to, amount)
+	}
+	return msgs
+}
+
+func (suite *KeeperTestSuite) TestTraceTxPredecessors() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	var (
+		txMsg        *types.MsgEthereumTx
+		predecessors []*types.MsgEthereumTx
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(msgs []*types.MsgEthereumTx)
+		expGas   uint64
+	}{
+		{
+			"no predecessors, balance of the recipient is unset",
+			func(msgs []*types.MsgEthereumTx) {
+				txMsg = msgs[1]
+				predecessors = nil
+			},
+			51928,
+		},
+		{
+			"predecessor sets the balance of the recipient first",
+			func(msgs []*types.MsgEthereumTx) {
+				txMsg = msgs[1]
+				predecessors = msgs[:1]
+			},
+			30828,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+
+			amount := sdk.NewIntWithDecimal(1, 18).BigInt()
+			tc.malleate(suite.deliverOnBranch(contractAddr, recipient, amount, amount))
+
+			traceReq := types.QueryTraceTxRequest{
+				Msg:          txMsg,
+				TxIndex:      uint64(len(predecessors)),
+				Predecessors: predecessors,
+			}
+			res, err := suite.queryClient.TraceTx(ctx, &traceReq)
+			suite.Require().NoError(err)
+
+			var result types.ExecutionResult
+			suite.Require().NoError(json.Unmarshal(res.Data, &result))
+			suite.Require().False(result.Failed)
+			suite.Require().Equal(tc.expGas, result.Gas)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceTxFeeGrant() {
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := tests.GenerateAddress()
+
+	// the predecessor transfers 1 token and pays 210000 tokens of fees
+	value, fees := big.NewInt(1), big.NewInt(210000)
+
+	testCases := []struct {
+		msg         string
+		feeGranters func(hash string) map[string]string
+		expFees     *big.Int
+	}{
+		{
+			"fees of the predecessor paid by the sender",
+			func(string) map[string]string { return nil },
+			fees,
+		},
+		{
+			"fees of the predecessor paid by the fee granter",
+			func(hash string) map[string]string { return map[string]string{hash: granter.String()} },
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.WithContext(suite.ctx)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter))
+			suite.app.EvmKeeper.AddBalance(common.BytesToAddress(granter), big.NewInt(1000000))
+			suite.app.EvmKeeper.AddBalance(suite.address, big.NewInt(1000000))
+			balance := suite.app.EvmKeeper.GetBalance(suite.address)
+
+			chainID := suite.app.EvmKeeper.ChainID()
+			nonce := suite.app.EvmKeeper.GetNonce(suite.address)
+			signer := ethtypes.LatestSignerForChainID(chainID)
+
+			predecessor := types.NewTx(chainID, nonce, &recipient, value, 21000, big.NewInt(10), nil, nil)
+			predecessor.From = suite.address.Hex()
+			suite.Require().NoError(predecessor.Sign(signer, suite.signer))
+
+			msg := types.NewTx(chainID, nonce+1, &recipient, value, 21000, big.NewInt(0), nil, nil)
+			msg.From = suite.address.Hex()
+			suite.Require().NoError(msg.Sign(signer, suite.signer))
+
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg:          msg,
+				TxIndex:      1,
+				TraceConfig:  &types.TraceConfig{Tracer: "prestateTracer"},
+				Predecessors: []*types.MsgEthereumTx{predecessor},
+				FeeGranters:  tc.feeGranters(predecessor.Hash),
+			})
+			suite.Require().NoError(err)
+
+			// the transaction is traced on the state left by the replayed predecessor
+			var prestate map[common.Address]struct {
+				Balance *hexutil.Big `json:"balance"`
+			}
+			suite.Require().NoError(json.Unmarshal(res.Data, &prestate))
+
+			expBalance := new(big.Int).Sub(balance, value)
+			expBalance.Sub(expBalance, tc.expFees)
+			suite.Require().Equal(expBalance, prestate[suite.address].Balance.ToInt())
+			suite.Require().Equal(value, prestate[recipient].Balance.ToInt())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceTxFailedPredecessors() {
+	recipient := tests.GenerateAddress()
+
+	// each predecessor transfers 1 token and pays 210000 tokens of fees
+	value, fees := big.NewInt(1), big.NewInt(210000)
+
+	testCases := []struct {
+		msg       string
+		nonces    []uint64
+		failedTxs func(hashes []string) map[string]uint64
+		expFees   *big.Int
+		expValue  *big.Int
+		expNonce  uint64
+	}{
+		{
+			"delivered predecessor",
+			[]uint64{0},
+			func([]string) map[string]uint64 { return nil },
+			fees,
+			value,
+			1,
+		},
+		{
+			"failed predecessor, only the fee deduction and the nonce increment are replayed",
+			[]uint64{0},
+			func(hashes []string) map[string]uint64 { return map[string]uint64{hashes[0]: 0} },
+			fees,
+			nil,
+			1,
+		},
+		{
+			"failed predecessors of two cosmos transactions",
+			[]uint64{0, 1},
+			func(hashes []string) map[string]uint64 { return map[string]uint64{hashes[0]: 0, hashes[1]: 1} },
+			new(big.Int).Mul(fees, big.NewInt(2)),
+			nil,
+			2,
+		},
+		{
+			"failed predecessors of a cosmos transaction with an invalid nonce, nothing is replayed",
+			[]uint64{0, 2},
+			func(hashes []string) map[string]uint64 { return map[string]uint64{hashes[0]: 0, hashes[1]: 0} },
+			big.NewInt(0),
+			nil,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.WithContext(suite.ctx)
+			suite.app.EvmKeeper.AddBalance(suite.address, big.NewInt(1000000))
+			balance := suite.app.EvmKeeper.GetBalance(suite.address)
+
+			chainID := suite.app.EvmKeeper.ChainID()
+			nonce := suite.app.EvmKeeper.GetNonce(suite.address)
+			signer := ethtypes.LatestSignerForChainID(chainID)
+
+			var (
+				predecessors []*types.MsgEthereumTx
+				hashes       []string
+			)
+			for _, n := range tc.nonces {
+				predecessor := types.NewTx(chainID, nonce+n, &recipient, value, 21000, big.NewInt(10), nil, nil)
+				predecessor.From = suite.address.Hex()
+				suite.Require().NoError(predecessor.Sign(signer, suite.signer))
+				predecessors = append(predecessors, predecessor)
+				hashes = append(hashes, predecessor.Hash)
+			}
+
+			msg := types.NewTx(chainID, nonce+tc.expNonce, &recipient, value, 21000, big.NewInt(0), nil, nil)
+			msg.From = suite.address.Hex()
+			suite.Require().NoError(msg.Sign(signer, suite.signer))
+
+			failedTxs := tc.failedTxs(hashes)
+			traceConfig := &types.TraceConfig{Tracer: "prestateTracer"}
+
+			// the predecessors are replayed on the query state
+			txCtx, _ := suite.ctx.CacheContext()
+			res, err := suite.app.EvmKeeper.TraceTx(sdk.WrapSDKContext(txCtx), &types.QueryTraceTxRequest{
+				Msg:          msg,
+				TxIndex:      uint64(len(predecessors) - len(failedTxs)),
+				TraceConfig:  traceConfig,
+				Predecessors: predecessors,
+				FailedTxs:    failedTxs,
+			})
+			suite.Require().NoError(err)
+
+			blockCtx, _ := suite.ctx.CacheContext()
+			resBlock, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(blockCtx), &types.QueryTraceBlockRequest{
+				Txs:         append(predecessors, msg),
+				TraceConfig: traceConfig,
+				FailedTxs:   failedTxs,
+			})
+			suite.Require().NoError(err)
+
+			// the failed transactions are not traced
+			var results []struct {
+				Result json.RawMessage `json:"result"`
+			}
+			suite.Require().NoError(json.Unmarshal(resBlock.Data, &results))
+			suite.Require().Len(results, len(predecessors)-len(failedTxs)+1)
+
+			// the transaction is traced on the state left by the replayed predecessors
+			for _, data := range [][]byte{res.Data, results[len(results)-1].Result} {
+				var prestate map[common.Address]struct {
+					Balance *hexutil.Big `json:"balance"`
+					Nonce   uint64       `json:"nonce"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &prestate))
+
+				expBalance := new(big.Int).Sub(balance, tc.expFees)
+				if tc.expValue != nil {
+					expBalance.Sub(expBalance, tc.expValue)
+				}
+				suite.Require().Equal(expBalance, prestate[suite.address].Balance.ToInt())
+				suite.Require().Equal(nonce+tc.expNonce, prestate[suite.address].Nonce)
+				if tc.expValue != nil {
+					suite.Require().Equal(tc.expValue, prestate[recipient].Balance.ToInt())
+				} else {
+					suite.Require().Zero(prestate[recipient].Balance.ToInt().Sign())
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceBlockContext() {
+	suite.SetupTest()
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+
+	// validator proposing the traced block
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	operator := tests.GenerateAddress()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(operator.Bytes()), priv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+
+	// the contract returns the number, the time and the coinbase of the block
+	contract := tests.GenerateAddress()
+	suite.app.EvmKeeper.SetCode(contract, []byte{
+		byte(vm.NUMBER), byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+		byte(vm.TIMESTAMP), byte(vm.PUSH1), 0x20, byte(vm.MSTORE),
+		byte(vm.COINBASE), byte(vm.PUSH1), 0x40, byte(vm.MSTORE),
+		byte(vm.PUSH1), 0x60, byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+	})
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	msg := types.NewTx(chainID, suite.app.EvmKeeper.GetNonce(suite.address), &contract, nil, 100000, big.NewInt(0), nil, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	// the traces are executed on the state of the parent block
+	blockNumber := suite.ctx.BlockHeight() + 1
+	blockTime := suite.ctx.BlockTime().Add(time.Minute)
+	proposer := sdk.ConsAddress(priv.PubKey().Address())
+	expOutput := append(append(
+		common.BigToHash(big.NewInt(blockNumber)).Bytes(),
+		common.BigToHash(big.NewInt(blockTime.Unix())).Bytes()...),
+		common.BytesToHash(operator.Bytes()).Bytes()...,
+	)
+
+	var trace struct {
+		Output hexutil.Bytes `json:"output"`
+	}
+
+	resTx, err := suite.app.EvmKeeper.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg:             msg,
+		TraceConfig:     &types.TraceConfig{Tracer: "callTracer"},
+		BlockNumber:     blockNumber,
+		BlockTime:       blockTime,
+		ProposerAddress: proposer,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(resTx.Data, &trace))
+	suite.Require().Equal(expOutput, []byte(trace.Output))
+
+	resBlock, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceBlockRequest{
+		Txs:             []*types.MsgEthereumTx{msg},
+		TraceConfig:     &types.TraceConfig{Tracer: "callTracer"},
+		BlockNumber:     blockNumber,
+		BlockTime:       blockTime,
+		ProposerAddress: proposer,
+	})
+	suite.Require().NoError(err)
+
+	var results []struct {
+		Result json.RawMessage `json:"result"`
+	}
+	suite.Require().NoError(json.Unmarshal(resBlock.Data, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().NoError(json.Unmarshal(results[0].Result, &trace))
+	suite.Require().Equal(expOutput, []byte(trace.Output))
+}
+
+func (suite *KeeperTestSuite) TestTraceTxInvalidFeeGranter() {
+	msgs := []*types.MsgEthereumTx{types.NewTx(suite.app.EvmKeeper.ChainID(), 0, &common.Address{}, nil, 21000, nil, nil, nil)}
+	msgs[0].Hash = common.Hash{}.Hex()
+	feeGranters := map[string]string{msgs[0].Hash: "invalid"}
+
+	_, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg: msgs[0], Predecessors: msgs, FeeGranters: feeGranters,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.TraceBlock(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceBlockRequest{
+		Txs: msgs, FeeGranters: feeGranters,
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	var (
+		args        []byte
+		traceConfig *types.TraceConfig
+		overrides   []byte
+	)
+
+	testCases := []struct {
+		msg           string
+		malleate      func(contractAddr common.Address, data []byte)
+		expPass       bool
+		traceResponse []byte
+	}{
+		{
+			"invalid args",
+			func(common.Address, []byte) {
+				args = []byte("invalid")
+			},
+			false,
+			nil,
+		},
+		{
+			"default trace",
+			func(contractAddr common.Address, data []byte) {
+				args, _ = json.Marshal(&types.CallArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+			},
+			true,
+			[]byte(`{"gas":51928,"failed":false,"returnValue":"","structLogs":[{"pc":0,"op":"PUSH1","gas":`),
+		},
+		{
+			"javascript tracer",
+			func(contractAddr common.Address, data []byte) {
+				args, _ = json.Marshal(&types.CallArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+				traceConfig = &types.TraceConfig{
+					Tracer: "{count: 0, fault: function(log) {}, step: function(log) { if(log.op.toString() == \"SSTORE\") this.count++; }, result: function() { return this.count; }}",
+				}
+			},
+			true,
+			[]byte("2"),
+		},
+		{
+			"code of the contract overridden",
+			func(contractAddr common.Address, data []byte) {
+				args, _ = json.Marshal(&types.CallArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+				code := hexutil.Bytes{}
+				overrides, _ = json.Marshal(types.StateOverride{contractAddr: {Code: &code}})
+			},
+			true,
+			[]byte(`{"gas":21632,"failed":false,"returnValue":"","structLogs":[]}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			traceConfig = nil
+			overrides = nil
+
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+
+			recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+			data, err := ContractABI.Pack("transfer", recipient, sdk.NewIntWithDecimal(1, 18).BigInt())
+			suite.Require().NoError(err)
+
+			tc.malleate(contractAddr, data)
+
+			res, err := suite.queryClient.TraceCall(ctx, &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      25_000_000,
+				TraceConfig: traceConfig,
+				Overrides:   overrides,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().GreaterOrEqual(len(res.Data), len(tc.traceResponse))
+				suite.Require().Equal(string(tc.traceResponse), string(res.Data[:len(tc.traceResponse)]))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceBlock() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	amount := sdk.NewIntWithDecimal(1, 18).BigInt()
+	msgs := suite.deliverOnBranch(contractAddr, recipient, amount, amount)
+
+	res, err := suite.queryClient.TraceBlock(ctx, &types.QueryTraceBlockRequest{Txs: msgs})
+	suite.Require().NoError(err)
+
+	var results []struct {
+		Result types.ExecutionResult `json:"result"`
+		Error  string                `json:"error"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 2)
+
+	// the second transfer is traced on the state left by the first one
+	expGas := []uint64{51928, 30828}
+	for i, result := range results {
+		suite.Require().Empty(result.Error)
+		suite.Require().False(result.Result.Failed)
+		suite.Require().Equal(expGas[i], result.Result.Gas)
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthCallStateOverrides() {
+	var (
+		contractAddr common.Address
+		overrides    types.StateOverride
+		callData     []byte
+	)
+
+	var balanceKey common.Hash
+
+	supply := sdk.NewIntWithDecimal(1000, 18).BigInt()
+	// storage slot of the total supply
+	supplyKey := common.BigToHash(big.NewInt(2))
+	overridden := common.BigToHash(big.NewInt(100))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expRet   *big.Int
+	}{
+		{
+			"no overrides",
+			func() {
+				overrides = nil
+				callData, _ = ContractABI.Pack("balanceOf", suite.address)
+			},
+			true,
+			supply,
+		},
+		{
+			"state diff overrides a single slot",
+			func() {
+				overrides = types.StateOverride{
+					contractAddr: {StateDiff: &map[common.Hash]common.Hash{balanceKey: overridden}},
+				}
+				callData, _ = ContractABI.Pack("balanceOf", suite.address)
+			},
+			true,
+			overridden.Big(),
+		},
+		{
+			"state diff keeps the other slots",
+			func() {
+				overrides = types.StateOverride{
+					contractAddr: {StateDiff: &map[common.Hash]common.Hash{balanceKey: overridden}},
+				}
+				callData, _ = ContractABI.Pack("totalSupply")
+			},
+			true,
+			supply,
+		},
+		{
+			"state replaces the whole storage",
+			func() {
+				overrides = types.StateOverride{
+					contractAddr: {State: &map[common.Hash]common.Hash{balanceKey: overridden}},
+				}
+				callData, _ = ContractABI.Pack("totalSupply")
+			},
+			true,
+			big.NewInt(0),
+		},
+		{
+			"state and state diff on the same account",
+			func() {
+				overrides = types.StateOverride{
+					contractAddr: {
+						State:     &map[common.Hash]common.Hash{},
+						StateDiff: &map[common.Hash]common.Hash{},
+					},
+				}
+				callData, _ = ContractABI.Pack("totalSupply")
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, supply)
+			suite.Commit()
+			// storage slot of the balance of the contract owner (balances mapping at slot 0)
+			balanceKey = crypto.Keccak256Hash(common.LeftPadBytes(suite.address.Bytes(), 32), common.LeftPadBytes(nil, 32))
+
+			tc.malleate()
+
+			args, err := json.Marshal(&types.CallArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&callData)})
+			suite.Require().NoError(err)
+			bz, err := json.Marshal(overrides)
+			suite.Require().NoError(err)
+
+			rsp, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:      args,
+				GasCap:    25_000_000,
+				Overrides: bz,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(rsp.VmError)
+				suite.Require().Zero(tc.expRet.Cmp(new(big.Int).SetBytes(rsp.Ret)))
 			} else {
 				suite.Require().Error(err)
 			}
+
+			// the overrides must not be persisted
+			suite.Require().Equal(common.BigToHash(supply), suite.app.EvmKeeper.GetState(contractAddr, balanceKey))
+			suite.Require().Equal(common.BigToHash(supply), suite.app.EvmKeeper.GetState(contractAddr, supplyKey))
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEstimateGasStateOverrides() {
+	suite.SetupTest()
+
+	sender := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	args, err := json.Marshal(&types.CallArgs{To: &common.Address{}, From: &sender, Value: (*hexutil.Big)(big.NewInt(100))})
+	suite.Require().NoError(err)
+
+	req := types.EthCallRequest{Args: args, GasCap: 25_000_000}
+
+	// the sender doesn't have funds
+	_, err = suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &req)
+	suite.Require().Error(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	req.Overrides, err = json.Marshal(types.StateOverride{sender: {Balance: &balance}})
+	suite.Require().NoError(err)
+
+	rsp, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(21000), rsp.Gas)
+	suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(sender).Int64())
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
@@ -18,6 +19,16 @@ func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
 	return hooks
 }
 
+// PreTxProcessing delegate the call to underlying hooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	for i := range mh {
+		if err := mh[i].PreTxProcessing(ctx, msg); err != nil {
+			return sdkerrors.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
 // PostTxProcessing delegate the call to underlying hooks
 func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, txHash common.Hash, logs []*ethtypes.Log) error {
 	for i := range mh {
@@ -27,3 +38,13 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, txHash common.Hash, lo
 	}
 	return nil
 }
+
+// PostTxExecution delegate the call to underlying hooks
+func (mh MultiEvmHooks) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	for i := range mh {
+		if err := mh[i].PostTxExecution(ctx, msg, res); err != nil {
+			return sdkerrors.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
@@ -6,8 +6,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/tharsis/ethermint/tests"
 	"github.com/tharsis/ethermint/x/evm/keeper"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
@@ -17,18 +19,73 @@ type LogRecordHook struct {
 	Logs []*ethtypes.Log
 }
 
+func (dh *LogRecordHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return nil
+}
+
 func (dh *LogRecordHook) PostTxProcessing(ctx sdk.Context, txHash common.Hash, logs []*ethtypes.Log) error {
 	dh.Logs = logs
 	return nil
 }
 
+func (dh *LogRecordHook) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	return nil
+}
+
 // FailureHook always fail
 type FailureHook struct{}
 
+func (dh FailureHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return errors.New("pre tx processing failed")
+}
+
 func (dh FailureHook) PostTxProcessing(ctx sdk.Context, txHash common.Hash, logs []*ethtypes.Log) error {
 	return errors.New("post tx processing failed")
 }
 
+func (dh FailureHook) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	return errors.New("post tx execution failed")
+}
+
+// PostFailureHook fails after the execution of the txs
+type PostFailureHook struct{}
+
+func (dh PostFailureHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return nil
+}
+
+func (dh PostFailureHook) PostTxProcessing(ctx sdk.Context, txHash common.Hash, logs []*ethtypes.Log) error {
+	return errors.New("post tx processing failed")
+}
+
+func (dh PostFailureHook) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	return errors.New("post tx execution failed")
+}
+
+// TxRecordHook rejects the txs sent to the blocked address and records the executed txs
+type TxRecordHook struct {
+	Blocked   common.Address
+	Msgs      []core.Message
+	Responses []*types.MsgEthereumTxResponse
+}
+
+func (dh *TxRecordHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	if msg.To() != nil && *msg.To() == dh.Blocked {
+		return errors.New("blocked recipient")
+	}
+	return nil
+}
+
+func (dh *TxRecordHook) PostTxProcessing(ctx sdk.Context, txHash common.Hash, logs []*ethtypes.Log) error {
+	return nil
+}
+
+func (dh *TxRecordHook) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	dh.Msgs = append(dh.Msgs, msg)
+	dh.Responses = append(dh.Responses, res)
+	return nil
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -74,3 +131,70 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestEvmHooksApplyTransaction() {
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	blocked := tests.GenerateAddress()
+
+	hook := &TxRecordHook{Blocked: blocked}
+	k := suite.app.EvmKeeper
+	k.SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	sendTx := func(to common.Address, data []byte) (*types.MsgEthereumTxResponse, error) {
+		chainID := k.ChainID()
+		k.WithContext(suite.ctx)
+		tx := types.NewTx(chainID, k.GetNonce(suite.address), &to, nil, 100000, nil, data, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+		return k.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	}
+
+	// the txs to the blocked address are rejected without being executed
+	_, err := sendTx(blocked, nil)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrTxRejected.Error())
+	suite.Require().Empty(hook.Msgs)
+
+	// the reverted txs are passed to the post execution hook
+	data, err := ContractABI.Pack("transfer", blocked, big.NewInt(1000))
+	suite.Require().NoError(err)
+	rsp, err := sendTx(contractAddr, data)
+	suite.Require().NoError(err)
+	suite.Require().True(rsp.Failed())
+
+	data, err = ContractABI.Pack("transfer", blocked, big.NewInt(10))
+	suite.Require().NoError(err)
+	rsp, err = sendTx(contractAddr, data)
+	suite.Require().NoError(err)
+	suite.Require().False(rsp.Failed())
+
+	suite.Require().Len(hook.Msgs, 2)
+	suite.Require().Equal(suite.address, hook.Msgs[0].From())
+	suite.Require().Equal(contractAddr, *hook.Msgs[1].To())
+	suite.Require().True(hook.Responses[0].Failed())
+	suite.Require().Equal(rsp, hook.Responses[1])
+	suite.Require().Len(hook.Responses[1].Logs, 1)
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksPostFailureApplyTransaction() {
+	suite.SetupTest()
+	suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(PostFailureHook{}))
+
+	k := suite.app.EvmKeeper
+	k.WithContext(suite.ctx)
+	k.AddBalance(suite.address, big.NewInt(100))
+	recipient := tests.GenerateAddress()
+
+	chainID := k.ChainID()
+	tx := types.NewTx(chainID, 0, &recipient, big.NewInt(10), 100000, nil, nil, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	// the tx is reverted once, even though both post execution hooks fail
+	rsp, err := k.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ErrPostTxProcessing.Error(), rsp.VmError)
+
+	k.WithContext(suite.ctx)
+	suite.Require().Zero(k.GetBalance(recipient).Sign())
+}
@@ -9,9 +9,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/palantir/stacktrace"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	ethermint "github.com/tharsis/ethermint/types"
 	"github.com/tharsis/ethermint/x/evm/types"
@@ -39,6 +41,10 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	// access historical headers for EVM state transition execution
 	stakingKeeper types.StakingKeeper
+	// access the EIP-1559 base fee of the fee market
+	feeMarketKeeper types.FeeMarketKeeper
+	// fund the community pool with the base fees
+	distrKeeper types.DistributionKeeper
 
 	// Manage the initial context and cache context stack for accessing the store,
 	// emit events and log info.
@@ -57,6 +63,9 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+
+	// stateful precompiled contracts registered by the app, indexed by address
+	precompiles map[common.Address]types.StatefulPrecompiledContract
 }
 
 // NewKeeper generates new evm module keeper
@@ -64,6 +73,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey, transientKey sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	fmk types.FeeMarketKeeper, dk types.DistributionKeeper,
 	tracer string, debug bool,
 ) *Keeper {
 	// ensure evm module account is set
@@ -78,15 +88,17 @@ func NewKeeper(
 
 	// NOTE: we pass in the parameter space to the CommitStateDB in order to use custom denominations for the EVM operations
 	return &Keeper{
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		accountKeeper: ak,
-		bankKeeper:    bankKeeper,
-		stakingKeeper: sk,
-		storeKey:      storeKey,
-		transientKey:  transientKey,
-		tracer:        tracer,
-		debug:         debug,
+		cdc:             cdc,
+		paramSpace:      paramSpace,
+		accountKeeper:   ak,
+		bankKeeper:      bankKeeper,
+		stakingKeeper:   sk,
+		feeMarketKeeper: fmk,
+		distrKeeper:     dk,
+		storeKey:        storeKey,
+		transientKey:    transientKey,
+		tracer:          tracer,
+		debug:           debug,
 	}
 }
 
@@ -220,6 +232,125 @@ func (k Keeper) ResetRefundTransient(ctx sdk.Context) {
 	store.Set(types.KeyPrefixTransientRefund, sdk.Uint64ToBigEndian(0))
 }
 
+// ResetGasUsedTransient resets the gas used by the ethereum transactions of the current cosmos
+// transaction to 0
+func (k Keeper) ResetGasUsedTransient(ctx sdk.Context) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientGasUsed, sdk.Uint64ToBigEndian(0))
+}
+
+// SetTxMsgsTransient sets the number of messages of the current cosmos transaction.
+func (k Keeper) SetTxMsgsTransient(ctx sdk.Context, msgs uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientTxMsgs, sdk.Uint64ToBigEndian(msgs))
+}
+
+// SetSimulationTransient records whether the current cosmos transaction is executed as a
+// simulation, e.g. by the gRPC Simulate service.
+func (k Keeper) SetSimulationTransient(ctx sdk.Context, simulate bool) {
+	store := ctx.TransientStore(k.transientKey)
+	if !simulate {
+		store.Delete(types.KeyPrefixTransientSimulation)
+		return
+	}
+	store.Set(types.KeyPrefixTransientSimulation, []byte{1})
+}
+
+// IsSimulationTransient returns true if the current cosmos transaction is executed as a
+// simulation.
+func (k Keeper) IsSimulationTransient(ctx sdk.Context) bool {
+	store := ctx.TransientStore(k.transientKey)
+	return store.Has(types.KeyPrefixTransientSimulation)
+}
+
+// GetTxMsgsTransient returns the number of messages of the current cosmos transaction.
+func (k Keeper) GetTxMsgsTransient() uint64 {
+	store := k.Ctx().TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientTxMsgs)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetGasUsedTransient returns the gas used by the ethereum transactions of the current cosmos
+// transaction.
+func (k Keeper) GetGasUsedTransient() uint64 {
+	store := k.Ctx().TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddGasUsedTransient adds the gas used by an ethereum transaction to the gas used by the current
+// cosmos transaction and returns the total.
+func (k Keeper) AddGasUsedTransient(gasUsed uint64) uint64 {
+	total := k.GetGasUsedTransient() + gasUsed
+	store := k.Ctx().TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientGasUsed, sdk.Uint64ToBigEndian(total))
+	return total
+}
+
+// GetBlockGasUsedTransient returns the gas used by the ethereum transactions of the current block.
+func (k Keeper) GetBlockGasUsedTransient() uint64 {
+	store := k.Ctx().TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlockGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddBlockGasUsedTransient adds the gas used by an ethereum transaction to the gas used by the
+// ethereum transactions of the current block.
+func (k Keeper) AddBlockGasUsedTransient(gasUsed uint64) {
+	store := k.Ctx().TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlockGasUsed, sdk.Uint64ToBigEndian(k.GetBlockGasUsedTransient()+gasUsed))
+}
+
+// GetBlockTxsRevertedTransient returns the number of ethereum transactions of the current block
+// whose execution failed.
+func (k Keeper) GetBlockTxsRevertedTransient() uint64 {
+	store := k.Ctx().TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlockTxsReverted)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncreaseBlockTxsRevertedTransient increases by one the number of ethereum transactions of the
+// current block whose execution failed.
+func (k Keeper) IncreaseBlockTxsRevertedTransient() {
+	store := k.Ctx().TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlockTxsReverted, sdk.Uint64ToBigEndian(k.GetBlockTxsRevertedTransient()+1))
+}
+
+// SetFeePayerTransient sets the account paying the fees of the given transaction, when it differs
+// from the sender, i.e. when the fees are paid through a fee grant.
+func (k Keeper) SetFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer sdk.AccAddress) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), feePayer.Bytes())
+}
+
+// GetFeePayerTransient returns the account paying the fees of the given transaction, or nil if the
+// fees are paid by the sender.
+func (k Keeper) GetFeePayerTransient(txHash common.Hash) sdk.AccAddress {
+	store := prefix.NewStore(k.Ctx().TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return sdk.AccAddress(bz)
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -278,10 +409,59 @@ func (k Keeper) IncreaseLogSizeTransient() {
 	store.Set(types.KeyPrefixTransientLogSize, sdk.Uint64ToBigEndian(logSize+1))
 }
 
+// EmitPrecompileLogs emits logs that are emitted outside of an Ethereum transaction, such as by the
+// IBC callbacks of a Cosmos transaction, as precompile log events of the transaction. The logs are
+// indexed in the block along with the logs of the Ethereum transactions and added to the block
+// bloom, so that they are returned by the JSON-RPC log queries of the block. As they don't belong
+// to an Ethereum transaction, their transaction hash is the Tendermint hash of the Cosmos
+// transaction and their transaction index is zero.
+func (k Keeper) EmitPrecompileLogs(ctx sdk.Context, logs []*ethtypes.Log) {
+	if len(logs) == 0 {
+		return
+	}
+
+	k.WithContext(ctx)
+
+	var txHash common.Hash
+	if len(ctx.TxBytes()) > 0 {
+		txHash = common.BytesToHash(tmtypes.Tx(ctx.TxBytes()).Hash())
+	}
+
+	attrs := make([]sdk.Attribute, 0, len(logs))
+	for _, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight())
+		log.BlockHash = common.BytesToHash(ctx.HeaderHash())
+		log.TxHash = txHash
+		log.TxIndex = 0
+		log.Index = uint(k.GetLogSizeTransient())
+		k.IncreaseLogSizeTransient()
+
+		bz := k.cdc.MustMarshal(types.NewLogFromEth(log))
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxLog, string(bz)))
+	}
+
+	bloom := k.GetBlockBloomTransient()
+	bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.LogsBloom(logs)))
+	k.SetBlockBloomTransient(bloom)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePrecompileLog, attrs...))
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
 
+// GetContractState returns the value of a storage slot of the given account on the given context.
+func (k Keeper) GetContractState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return doGetState(ctx, k.storeKey, addr, key)
+}
+
+// DeleteContractState deletes a storage slot of the given account on the given context.
+func (k Keeper) DeleteContractState(ctx sdk.Context, addr common.Address, key common.Hash) {
+	k.WithContext(ctx)
+	k.DeleteState(addr, key)
+}
+
 // GetAccountStorage return state storage associated with an account
 func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) (types.Storage, error) {
 	storage := types.Storage{}
@@ -353,16 +533,35 @@ func (k Keeper) ResetAccount(addr common.Address) {
 	k.DeleteAccountStorage(addr)
 }
 
-// SetHooks sets the hooks for the EVM module
+// SetHooks sets the hooks for the EVM module. The hooks set as MultiEvmHooks can be extended by
+// further calls, so that several modules can register their hooks; it panics otherwise.
 func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
-	if k.hooks != nil {
+	if k.hooks == nil {
+		k.hooks = eh
+		return k
+	}
+
+	hooks, ok := k.hooks.(MultiEvmHooks)
+	if !ok {
 		panic("cannot set evm hooks twice")
 	}
 
-	k.hooks = eh
+	if multi, ok := eh.(MultiEvmHooks); ok {
+		k.hooks = append(hooks, multi...)
+	} else {
+		k.hooks = append(hooks, eh)
+	}
 	return k
 }
 
+// PreTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(msg core.Message) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PreTxProcessing(k.Ctx(), msg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(txHash common.Hash, logs []*ethtypes.Log) error {
 	if k.hooks == nil {
@@ -370,3 +569,11 @@ func (k *Keeper) PostTxProcessing(txHash common.Hash, logs []*ethtypes.Log) erro
 	}
 	return k.hooks.PostTxProcessing(k.Ctx(), txHash, logs)
 }
+
+// PostTxExecution delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PostTxExecution(msg core.Message, res *types.MsgEthereumTxResponse) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PostTxExecution(k.Ctx(), msg, res)
+}
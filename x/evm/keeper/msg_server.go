@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/palantir/stacktrace"
 
@@ -10,6 +11,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/tharsis/ethermint/x/evm/types"
 )
@@ -26,16 +28,30 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 
 	sender := msg.From
 	tx := msg.AsTransaction()
+	txIndex := k.GetTxIndexTransient()
 
 	response, err := k.ApplyTransaction(tx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "failed to apply transaction")
 	}
 
+	// the messages of a cosmos transaction containing several ethereum transactions are executed
+	// atomically, so that a reverted message fails the whole cosmos transaction
+	if response.Failed() && k.GetTxMsgsTransient() > 1 {
+		return nil, stacktrace.Propagate(
+			sdkerrors.Wrapf(types.ErrVMExecution, "ethereum tx %s reverted: %s", response.Hash, response.VmError),
+			"failed to apply transaction of a multi-message cosmos transaction",
+		)
+	}
+
 	attrs := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyAmount, tx.Value().String()),
 		// add event for ethereum transaction hash format
 		sdk.NewAttribute(types.AttributeKeyEthereumTxHash, response.Hash),
+		// add event for the index of the ethereum transaction in the block and its gas used, as a
+		// cosmos transaction can contain multiple ethereum transactions
+		sdk.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(txIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(response.GasUsed, 10)),
 	}
 
 	if len(ctx.TxBytes()) > 0 {
@@ -76,5 +92,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		),
 	})
 
+	// the metrics of the ethereum transactions are only emitted once the last message of the cosmos
+	// transaction succeeds, as a failed message discards the whole cosmos transaction
+	if !ctx.IsCheckTx() {
+		k.emitTxMetrics(ctx)
+	}
+
 	return response, nil
 }
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// revertCode is the code executed in place of the code of a contract on a blocked call. It
+// reverts the call frame without consuming its gas.
+var revertCode = []byte{
+	byte(vm.PUSH1), 0x00, // size
+	byte(vm.PUSH1), 0x00, // offset
+	byte(vm.REVERT),
+}
+
+// permissions enforces the AllowedDeployers and CallBlocklists module parameters on the execution
+// of a message. The sender of the message is checked before its execution, and the internal calls
+// and contract creations by the hook of the EVM, which fails the offending frames on their entry.
+// The first violation is recorded, and fails the whole message once its execution completes, even
+// if the calling contract handles the failure of the frame.
+type permissions struct {
+	// deployers is the set of the addresses allowed to deploy contracts, nil if any address is
+	deployers map[common.Address]bool
+	// blocklists is the set of the blocked callers of each contract
+	blocklists map[common.Address]map[common.Address]bool
+
+	// err is the first violation of the execution
+	err error
+}
+
+// newPermissions returns the permissions defined by the module parameters, or nil if they don't
+// restrict the contract deployments nor calls.
+func newPermissions(params types.Params) *permissions {
+	if len(params.AllowedDeployers) == 0 && len(params.CallBlocklists) == 0 {
+		return nil
+	}
+
+	p := &permissions{
+		blocklists: make(map[common.Address]map[common.Address]bool),
+	}
+
+	if len(params.AllowedDeployers) > 0 {
+		p.deployers = make(map[common.Address]bool)
+		for _, deployer := range params.AllowedDeployers {
+			p.deployers[common.HexToAddress(deployer)] = true
+		}
+	}
+
+	for _, blocklist := range params.CallBlocklists {
+		callers := make(map[common.Address]bool)
+		for _, caller := range blocklist.BlockedCallers {
+			callers[common.HexToAddress(caller)] = true
+		}
+		p.blocklists[common.HexToAddress(blocklist.Contract)] = callers
+	}
+
+	return p
+}
+
+// checkMessage checks the permissions of the sender of a message, which are enforced before its
+// execution. It returns false if the message must not be executed.
+func (p *permissions) checkMessage(from common.Address, to *common.Address) bool {
+	if to == nil {
+		return p.checkDeployment(from)
+	}
+	return p.checkCall(from, *to)
+}
+
+// checkDeployment returns false and records the violation if the deployer isn't allowed.
+func (p *permissions) checkDeployment(deployer common.Address) bool {
+	if p.deployers == nil || p.deployers[deployer] {
+		return true
+	}
+
+	if p.err == nil {
+		p.err = sdkerrors.Wrapf(types.ErrDeployerNotAllowed, "deployer %s", deployer)
+	}
+	return false
+}
+
+// checkCall returns false and records the violation if the caller of the contract is blocked.
+func (p *permissions) checkCall(caller, contract common.Address) bool {
+	if !p.blocklists[contract][caller] {
+		return true
+	}
+
+	if p.err == nil {
+		p.err = sdkerrors.Wrapf(types.ErrCallerBlocked, "caller %s of contract %s", caller, contract)
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// factoryCode is the code of a contract that deploys an empty contract, whose init code writes to its
+// storage, and returns its address, or zero if the deployment failed.
+var factoryCode = []byte{
+	// init code: PUSH1 0x01 PUSH1 0x00 SSTORE STOP
+	byte(vm.PUSH6), byte(vm.PUSH1), 0x01, byte(vm.PUSH1), 0x00, byte(vm.SSTORE), byte(vm.STOP),
+	byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+	byte(vm.PUSH1), 0x06, // size
+	byte(vm.PUSH1), 0x1a, // offset
+	byte(vm.PUSH1), 0x00, // value
+	byte(vm.CREATE),
+	byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+	byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+}
+
+// maxBlockedGasUsed is the gas used by a message running the init code of the contract deployed by
+// the factory, which the blocked frames fail before executing.
+const maxBlockedGasUsed = params.TxGas + params.CreateGas + params.SstoreSetGasEIP2200
+
+func (suite *KeeperTestSuite) setPermissions(deployers []string, blocklists []types.CallBlocklist) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.AllowedDeployers = deployers
+	params.CallBlocklists = blocklists
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) deployTx(data []byte) *types.MsgEthereumTxResponse {
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.address)
+
+	tx := types.NewTxContract(chainID, nonce, nil, 100000, nil, data, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	return rsp
+}
+
+func (suite *KeeperTestSuite) TestDeploymentAllowlist() {
+	var factory common.Address
+
+	testCases := []struct {
+		msg       string
+		deployers func() []string
+		tx        func() *types.MsgEthereumTxResponse
+		create    bool
+		expPass   bool
+	}{
+		{
+			"any deployer allowed",
+			func() []string { return nil },
+			func() *types.MsgEthereumTxResponse { return suite.deployTx(nil) },
+			true,
+			true,
+		},
+		{
+			"deployer allowed",
+			func() []string { return []string{suite.address.Hex()} },
+			func() *types.MsgEthereumTxResponse { return suite.deployTx(nil) },
+			true,
+			true,
+		},
+		{
+			"deployer not allowed",
+			func() []string { return []string{tests.GenerateAddress().Hex()} },
+			func() *types.MsgEthereumTxResponse { return suite.deployTx(nil) },
+			true,
+			false,
+		},
+		{
+			"factory allowed",
+			func() []string { return []string{factory.Hex()} },
+			func() *types.MsgEthereumTxResponse { return suite.sendTx(factory, nil, 100000) },
+			false,
+			true,
+		},
+		{
+			"factory not allowed",
+			func() []string { return []string{suite.address.Hex()} },
+			func() *types.MsgEthereumTxResponse { return suite.sendTx(factory, nil, 100000) },
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			factory = tests.GenerateAddress()
+			suite.app.EvmKeeper.SetCode(factory, factoryCode)
+			suite.setPermissions(tc.deployers(), nil)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.address)
+			rsp := tc.tx()
+
+			if tc.expPass {
+				suite.Require().False(rsp.Failed(), rsp.VmError)
+			} else {
+				suite.Require().True(rsp.Failed())
+				suite.Require().Contains(rsp.VmError, types.ErrDeployerNotAllowed.Error())
+				suite.Require().Less(rsp.GasUsed, maxBlockedGasUsed)
+				// the state changes of the message are reverted
+				suite.Require().Zero(suite.app.EvmKeeper.GetNonce(factory))
+			}
+
+			// the nonce of the sender is incremented by the contract creations in any case, and
+			// by the ante handler for the calls
+			if tc.create {
+				nonce++
+			}
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCallBlocklist() {
+	var (
+		target    common.Address
+		forwarder common.Address
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func() (common.Address, []types.CallBlocklist)
+		expPass  bool
+	}{
+		{
+			"caller not blocked",
+			func() (common.Address, []types.CallBlocklist) {
+				return target, []types.CallBlocklist{{Contract: target.Hex(), BlockedCallers: []string{tests.GenerateAddress().Hex()}}}
+			},
+			true,
+		},
+		{
+			"caller blocked",
+			func() (common.Address, []types.CallBlocklist) {
+				return target, []types.CallBlocklist{{Contract: target.Hex(), BlockedCallers: []string{suite.address.Hex()}}}
+			},
+			false,
+		},
+		{
+			"internal caller not blocked",
+			func() (common.Address, []types.CallBlocklist) {
+				forwarder = tests.GenerateAddress()
+				suite.app.EvmKeeper.SetCode(forwarder, callerCode(vm.CALL, target, false))
+				return forwarder, []types.CallBlocklist{{Contract: target.Hex(), BlockedCallers: []string{suite.address.Hex()}}}
+			},
+			true,
+		},
+		{
+			"internal caller blocked",
+			func() (common.Address, []types.CallBlocklist) {
+				forwarder = tests.GenerateAddress()
+				suite.app.EvmKeeper.SetCode(forwarder, callerCode(vm.CALL, target, false))
+				return forwarder, []types.CallBlocklist{{Contract: target.Hex(), BlockedCallers: []string{forwarder.Hex()}}}
+			},
+			false,
+		},
+		{
+			"internal caller blocked with STATICCALL",
+			func() (common.Address, []types.CallBlocklist) {
+				forwarder = tests.GenerateAddress()
+				suite.app.EvmKeeper.SetCode(forwarder, callerCode(vm.STATICCALL, target, false))
+				return forwarder, []types.CallBlocklist{{Contract: target.Hex(), BlockedCallers: []string{forwarder.Hex()}}}
+			},
+			false,
+		},
+		{
+			"internal caller blocked with DELEGATECALL",
+			func() (common.Address, []types.CallBlocklist) {
+				forwarder = tests.GenerateAddress()
+				suite.app.EvmKeeper.SetCode(forwarder, callerCode(vm.DELEGATECALL, target, false))
+				return forwarder, []types.CallBlocklist{{Contract: target.Hex(), BlockedCallers: []string{forwarder.Hex()}}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			// the target contract deploys a contract when called, so that the reverted state
+			// changes can be checked
+			target = tests.GenerateAddress()
+			suite.app.EvmKeeper.SetCode(target, factoryCode)
+
+			to, blocklists := tc.malleate()
+			suite.setPermissions(nil, blocklists)
+
+			rsp := suite.sendTx(to, nil, 100000)
+			if tc.expPass {
+				suite.Require().False(rsp.Failed(), rsp.VmError)
+				suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(target))
+			} else {
+				suite.Require().True(rsp.Failed())
+				suite.Require().Contains(rsp.VmError, types.ErrCallerBlocked.Error())
+				suite.Require().Less(rsp.GasUsed, maxBlockedGasUsed)
+				suite.Require().Zero(suite.app.EvmKeeper.GetNonce(target))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPermissions() {
+	contract := tests.GenerateAddress()
+	caller := tests.GenerateAddress()
+	suite.setPermissions(
+		[]string{suite.address.Hex()},
+		[]types.CallBlocklist{{Contract: contract.Hex(), BlockedCallers: []string{caller.Hex()}}},
+	)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	allowlist, err := suite.queryClient.DeploymentAllowlist(ctx, &types.QueryDeploymentAllowlistRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{suite.address.Hex()}, allowlist.AllowedDeployers)
+
+	blocklist, err := suite.queryClient.CallBlocklist(ctx, &types.QueryCallBlocklistRequest{Contract: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{caller.Hex()}, blocklist.BlockedCallers)
+
+	blocklist, err = suite.queryClient.CallBlocklist(ctx, &types.QueryCallBlocklistRequest{Contract: caller.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(blocklist.BlockedCallers)
+
+	_, err = suite.queryClient.CallBlocklist(ctx, &types.QueryCallBlocklistRequest{Contract: "0x01"})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// revertSelector is the selector of the Error(string) revert reason
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// RegisterPrecompiles registers the given stateful precompiled contracts on the keeper. A
+// registered precompile is only executed once its address is added to the ActivePrecompiles
+// module parameter. This function panics if the address of a precompile is already used by a
+// default or a registered precompile.
+func (k *Keeper) RegisterPrecompiles(precompiles ...types.StatefulPrecompiledContract) *Keeper {
+	if k.precompiles == nil {
+		k.precompiles = make(map[common.Address]types.StatefulPrecompiledContract)
+	}
+
+	for _, precompile := range precompiles {
+		address := precompile.Address()
+		if _, found := vm.PrecompiledContractsBerlin[address]; found {
+			panic(fmt.Sprintf("precompile address %s is used by a default precompiled contract", address))
+		}
+
+		if _, found := k.precompiles[address]; found {
+			panic(fmt.Sprintf("precompile address %s registered twice", address))
+		}
+
+		k.precompiles[address] = precompile
+	}
+
+	return k
+}
+
+// ActivePrecompiles returns the addresses of the default precompiled contracts for the given
+// chain rules, followed by the ones of the enabled stateful precompiled contracts.
+func (k Keeper) ActivePrecompiles(rules params.Rules, params types.Params) []common.Address {
+	addresses := vm.ActivePrecompiles(rules)
+	for _, hex := range params.ActivePrecompiles {
+		address := common.HexToAddress(hex)
+		if _, found := k.precompiles[address]; found {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// activePrecompiles returns the registered stateful precompiled contracts that are enabled on the
+// given module parameters.
+func (k Keeper) activePrecompiles(params types.Params) map[common.Address]types.StatefulPrecompiledContract {
+	active := make(map[common.Address]types.StatefulPrecompiledContract)
+	for _, hex := range params.ActivePrecompiles {
+		address := common.HexToAddress(hex)
+		if precompile, found := k.precompiles[address]; found {
+			active[address] = precompile
+		}
+	}
+	return active
+}
+
+// runPrecompile executes the given stateful precompiled contract on the current context of the
+// stack. The EVM gas is charged by the precompile, so the execution is not metered by the context
+// gas meter. Panics are recovered and returned as errors.
+func (k *Keeper) runPrecompile(
+	precompile types.StatefulPrecompiledContract, evm *vm.EVM, contract *vm.Contract, readOnly bool,
+) (ret []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret, err = nil, fmt.Errorf("precompile %s panicked: %v", precompile.Address(), r)
+		}
+	}()
+
+	ctx := k.Ctx().WithGasMeter(sdk.NewInfiniteGasMeter())
+	return precompile.Run(ctx, evm, contract, readOnly)
+}
+
+// revertReason returns the ABI encoded Error(string) revert reason for the given message.
+func revertReason(reason string) []byte {
+	typ, _ := abi.NewType("string", "", nil)
+	packed, err := (abi.Arguments{{Type: typ}}).Pack(reason)
+	if err != nil {
+		return nil
+	}
+	return append(append([]byte{}, revertSelector...), packed...)
+}
@@ -0,0 +1,352 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm/keeper"
+	"github.com/tharsis/ethermint/x/evm/tracers/native"
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	counterAddress = common.HexToAddress("0x0000000000000000000000000000000000000900")
+	counterKey     = []byte("counter")
+)
+
+// counterPrecompile increments a counter stored on the evm store on each call, and returns the
+// caller followed by the counter value.
+type counterPrecompile struct {
+	storeKey sdk.StoreKey
+}
+
+func (p counterPrecompile) Address() common.Address {
+	return counterAddress
+}
+
+func (p counterPrecompile) RequiredGas(ctx sdk.Context, input []byte) uint64 {
+	return 1000
+}
+
+func (p counterPrecompile) Run(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	switch string(contract.Input) {
+	case "revert":
+		return []byte("reverted"), vm.ErrExecutionReverted
+	case "fail":
+		return nil, errors.New("failed")
+	case "panic":
+		panic("panicked")
+	}
+
+	store := ctx.KVStore(p.storeKey)
+	count := new(big.Int).SetBytes(store.Get(counterKey))
+	if !readOnly {
+		count.Add(count, big.NewInt(1))
+		store.Set(counterKey, count.Bytes())
+	}
+
+	return append(common.LeftPadBytes(contract.Caller().Bytes(), 32), common.BigToHash(count).Bytes()...), nil
+}
+
+// callerCode returns the code of a contract that calls the given address with the given opcode,
+// and returns or reverts with the success flag of the call.
+func callerCode(op vm.OpCode, address common.Address, revert bool) []byte {
+	code := []byte{
+		byte(vm.PUSH1), 0x00, // retSize
+		byte(vm.PUSH1), 0x00, // retOffset
+		byte(vm.PUSH1), 0x00, // argsSize
+		byte(vm.PUSH1), 0x00, // argsOffset
+	}
+	if op == vm.CALL || op == vm.CALLCODE {
+		code = append(code, byte(vm.PUSH1), 0x00) // value
+	}
+	code = append(code, byte(vm.PUSH20))
+	code = append(code, address.Bytes()...)
+	code = append(code,
+		byte(vm.GAS), byte(op),
+		byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00,
+	)
+	if revert {
+		return append(code, byte(vm.REVERT))
+	}
+	return append(code, byte(vm.RETURN))
+}
+
+func (suite *KeeperTestSuite) sendTx(to common.Address, data []byte, gasLimit uint64) *types.MsgEthereumTxResponse {
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.address)
+
+	tx := types.NewTx(chainID, nonce, &to, nil, gasLimit, nil, data, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	return rsp
+}
+
+func (suite *KeeperTestSuite) TestStatefulPrecompiles() {
+	success := common.BigToHash(big.NewInt(1)).Bytes()
+	failure := common.Hash{}.Bytes()
+
+	counter := func() int64 {
+		store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+		return new(big.Int).SetBytes(store.Get(counterKey)).Int64()
+	}
+
+	deploy := func(code []byte) common.Address {
+		address := tests.GenerateAddress()
+		suite.app.EvmKeeper.SetCode(address, code)
+		return address
+	}
+
+	testCases := []struct {
+		msg        string
+		malleate   func() (common.Address, []byte, uint64)
+		enabled    bool
+		expVMError string
+		expRet     func() []byte
+		expGasUsed uint64
+		expCounter int64
+	}{
+		{
+			"precompile not enabled",
+			func() (common.Address, []byte, uint64) {
+				return counterAddress, nil, 100000
+			},
+			false,
+			"",
+			nil,
+			21000,
+			0,
+		},
+		{
+			"direct call",
+			func() (common.Address, []byte, uint64) {
+				return counterAddress, nil, 100000
+			},
+			true,
+			"",
+			func() []byte {
+				return append(common.LeftPadBytes(suite.address.Bytes(), 32), common.BigToHash(big.NewInt(1)).Bytes()...)
+			},
+			// intrinsic gas + precompile gas + stub code gas
+			21000 + 1000 + 23,
+			1,
+		},
+		{
+			"reverted call",
+			func() (common.Address, []byte, uint64) {
+				return counterAddress, []byte("revert"), 100000
+			},
+			true,
+			vm.ErrExecutionReverted.Error(),
+			func() []byte { return []byte("reverted") },
+			0,
+			0,
+		},
+		{
+			"failed call",
+			func() (common.Address, []byte, uint64) {
+				return counterAddress, []byte("fail"), 100000
+			},
+			true,
+			vm.ErrExecutionReverted.Error(),
+			nil,
+			0,
+			0,
+		},
+		{
+			"panic",
+			func() (common.Address, []byte, uint64) {
+				return counterAddress, []byte("panic"), 100000
+			},
+			true,
+			vm.ErrExecutionReverted.Error(),
+			nil,
+			0,
+			0,
+		},
+		{
+			"out of gas",
+			func() (common.Address, []byte, uint64) {
+				return counterAddress, nil, 21500
+			},
+			true,
+			vm.ErrOutOfGas.Error(),
+			nil,
+			21500,
+			0,
+		},
+		{
+			"call from a contract",
+			func() (common.Address, []byte, uint64) {
+				return deploy(callerCode(vm.CALL, counterAddress, false)), nil, 100000
+			},
+			true,
+			"",
+			func() []byte { return success },
+			0,
+			1,
+		},
+		{
+			"call from a contract reverted by the caller",
+			func() (common.Address, []byte, uint64) {
+				return deploy(callerCode(vm.CALL, counterAddress, true)), nil, 100000
+			},
+			true,
+			vm.ErrExecutionReverted.Error(),
+			func() []byte { return success },
+			0,
+			0,
+		},
+		{
+			"static call from a contract",
+			func() (common.Address, []byte, uint64) {
+				return deploy(callerCode(vm.STATICCALL, counterAddress, false)), nil, 100000
+			},
+			true,
+			"",
+			func() []byte { return success },
+			0,
+			0,
+		},
+		{
+			"delegate call from a contract",
+			func() (common.Address, []byte, uint64) {
+				return deploy(callerCode(vm.DELEGATECALL, counterAddress, false)), nil, 100000
+			},
+			true,
+			"",
+			func() []byte { return failure },
+			0,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.RegisterPrecompiles(counterPrecompile{storeKey: suite.app.GetKey(types.StoreKey)})
+
+			if tc.enabled {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.ActivePrecompiles = []string{counterAddress.Hex()}
+				suite.app.EvmKeeper.SetParams(suite.ctx, params)
+			}
+
+			to, data, gasLimit := tc.malleate()
+			rsp := suite.sendTx(to, data, gasLimit)
+
+			suite.Require().Equal(tc.expVMError, rsp.VmError)
+			if tc.expRet != nil {
+				suite.Require().Equal(tc.expRet(), rsp.Ret)
+			}
+			if tc.expGasUsed != 0 {
+				suite.Require().Equal(tc.expGasUsed, rsp.GasUsed)
+			}
+			suite.Require().Equal(tc.expCounter, counter())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterPrecompiles() {
+	storeKey := suite.app.GetKey(types.StoreKey)
+	suite.app.EvmKeeper.RegisterPrecompiles(counterPrecompile{storeKey: storeKey})
+
+	// the address is already registered
+	suite.Require().Panics(func() {
+		suite.app.EvmKeeper.RegisterPrecompiles(counterPrecompile{storeKey: storeKey})
+	})
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	rules := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID()).Rules(big.NewInt(suite.ctx.BlockHeight()))
+	suite.Require().NotContains(suite.app.EvmKeeper.ActivePrecompiles(rules, params), counterAddress)
+
+	cfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	msg := ethtypes.NewMessage(suite.address, &counterAddress, 0, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	stateDB := suite.app.EvmKeeper.NewEVM(msg, cfg, params, common.Address{}, nil).StateDB
+	suite.Require().Equal(types.EmptyCodeHash, stateDB.GetCodeHash(counterAddress).Bytes())
+	suite.Require().False(stateDB.Exist(counterAddress))
+
+	params.ActivePrecompiles = []string{counterAddress.Hex()}
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.Require().Contains(suite.app.EvmKeeper.ActivePrecompiles(rules, params), counterAddress)
+
+	// the precompiles enabled are resolved when the EVM is created
+	suite.Require().Empty(stateDB.GetCode(counterAddress))
+
+	stateDB = suite.app.EvmKeeper.NewEVM(msg, cfg, params, common.Address{}, nil).StateDB
+	suite.Require().Equal(types.PrecompileStubCodeHash, stateDB.GetCodeHash(counterAddress))
+	suite.Require().Equal(types.PrecompileStubCode, stateDB.GetCode(counterAddress))
+	suite.Require().True(stateDB.Exist(counterAddress))
+	suite.Require().False(stateDB.Empty(counterAddress))
+
+	// the keeper only returns the stored account
+	suite.Require().Equal(types.EmptyCodeHash, suite.app.EvmKeeper.GetCodeHash(counterAddress).Bytes())
+}
+
+func (suite *KeeperTestSuite) TestStatefulPrecompilesTrace() {
+	suite.SetupTest()
+
+	// the tracers are only called in debug mode
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetTKey(types.TransientKey),
+		suite.app.GetSubspace(types.ModuleName), suite.app.AccountKeeper, suite.app.BankKeeper,
+		suite.app.StakingKeeper, suite.app.FeeMarketKeeper, suite.app.DistrKeeper, "", true,
+	)
+	k.WithChainID(suite.ctx)
+	k.RegisterPrecompiles(counterPrecompile{storeKey: suite.app.GetKey(types.StoreKey)})
+
+	params := k.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{counterAddress.Hex()}
+	k.SetParams(suite.ctx, params)
+	k.WithContext(suite.ctx)
+
+	caller := tests.GenerateAddress()
+	k.SetCode(caller, callerCode(vm.CALL, counterAddress, false))
+
+	cfg := params.ChainConfig.EthereumConfig(k.ChainID())
+	msg := ethtypes.NewMessage(suite.address, &caller, k.GetNonce(suite.address), big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	tracer := vm.NewStructLogger(nil)
+	evm := k.NewEVM(msg, cfg, params, common.Address{}, tracer)
+
+	res, err := k.ApplyMessage(evm, msg, cfg, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), res.Ret)
+
+	// the precompile is traced like the default ones, without the operations of its stub code
+	logs := tracer.StructLogs()
+	suite.Require().NotEmpty(logs)
+	for _, log := range logs {
+		suite.Require().Equal(1, log.Depth, log.Op.String())
+	}
+
+	// the native tracers skip the calls to the stateful precompiles like the default ones
+	rules := cfg.Rules(big.NewInt(suite.ctx.BlockHeight()))
+	callTracer, ok := native.New("callTracer", k.ActivePrecompiles(rules, params))
+	suite.Require().True(ok)
+
+	msg = ethtypes.NewMessage(suite.address, &caller, k.GetNonce(suite.address), big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	evm = k.NewEVM(msg, cfg, params, common.Address{}, callTracer)
+	res, err = k.ApplyMessage(evm, msg, cfg, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	trace, err := callTracer.GetResult()
+	suite.Require().NoError(err)
+	var frame map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(trace, &frame))
+	suite.Require().Equal(strings.ToLower(caller.Hex()), frame["to"])
+	suite.Require().NotContains(frame, "calls")
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// ApplyStateOverrides overrides the accounts state on the current context before a
+// message call is executed. It follows the go-ethereum semantics: the `state` field
+// replaces the whole account storage while `stateDiff` only patches the given slots.
+// The caller is responsible for running it on a context that is later discarded.
+func (k *Keeper) ApplyStateOverrides(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			k.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			k.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			k.setBalance(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			k.clearAccountStorage(addr)
+			for key, value := range *account.State {
+				k.SetState(addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				k.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// setBalance sets the EVM denomination balance of the address to the given amount.
+func (k *Keeper) setBalance(addr common.Address, amount *big.Int) {
+	if amount == nil {
+		amount = new(big.Int)
+	}
+
+	diff := new(big.Int).Sub(amount, k.GetBalance(addr))
+	switch diff.Sign() {
+	case 1:
+		k.AddBalance(addr, diff)
+	case -1:
+		k.SubBalance(addr, diff.Neg(diff))
+	}
+}
+
+// clearAccountStorage removes every storage slot of the given address from the store.
+func (k *Keeper) clearAccountStorage(addr common.Address) {
+	store := prefix.NewStore(k.Ctx().KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
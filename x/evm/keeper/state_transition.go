@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/palantir/stacktrace"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -32,6 +33,12 @@ func (k *Keeper) NewEVM(
 	coinbase common.Address,
 	tracer vm.Tracer,
 ) *vm.EVM {
+	// the BASEFEE operation returns zero when the base fee isn't enforced
+	baseFee := k.GetBaseFee(k.Ctx())
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
@@ -41,12 +48,28 @@ func (k *Keeper) NewEVM(
 		BlockNumber: big.NewInt(k.Ctx().BlockHeight()),
 		Time:        big.NewInt(k.Ctx().BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
+		BaseFee:     baseFee,
 	}
 
 	txCtx := core.NewEVMTxContext(msg)
 	vmConfig := k.VMConfig(msg, params, tracer)
 
-	return vm.NewEVM(blockCtx, txCtx, k, config, vmConfig)
+	// the stateful precompiled contracts and the contract deployment and call permissions are
+	// resolved once per EVM and enforced by a hook, which requires the debug mode on all the
+	// transactions once a precompile or a permission is enabled. See evmHook for its overhead.
+	var stateDB vm.StateDB = k
+	precompiles, permissions := k.activePrecompiles(params), newPermissions(params)
+	if len(precompiles) > 0 || permissions != nil {
+		if !vmConfig.Debug {
+			vmConfig.Tracer = nil
+		}
+		vmConfig.Debug = true
+		hook := newEVMHook(k, precompiles, permissions, vmConfig.Tracer)
+		vmConfig.Tracer = hook
+		stateDB = hook
+	}
+
+	return vm.NewEVM(blockCtx, txCtx, stateDB, config, vmConfig)
 }
 
 // VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
@@ -126,10 +149,34 @@ func (k Keeper) GetHashFn() vm.GetHashFunc {
 // in order to ignore the SDK gas consumption config values (read, write, has, delete).
 // After the execution, the gas used from the message execution will be added to the starting gas consumed, taking into
 // consideration the amount of gas returned. Finally, the context is updated with the EVM gas consumed value prior to
-// returning.
+// returning. The gas used by the Ethereum transactions of the same Cosmos transaction is accumulated on the transient
+// store, which is reset by the AnteHandler, so that the gas used by the Cosmos transaction is the sum of the gas used
+// by its Ethereum transactions.
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
+//
+// Tx index
+//
+// The transaction is assigned the next Ethereum transaction index of the block, starting from zero, which is
+// incremented after its execution.
+//
+// Base fee
+//
+// When the EIP-1559 base fee is enforced by the fee market, the base fee part of the fees paid for the gas used, i.e.
+// gasUsed * baseFee, is transferred from the fee collector after the execution to the destination defined by the fee
+// market params: it is either burned, kept by the fee collector or sent to the community pool. The remaining priority
+// fees are kept by the fee collector and distributed to the validators.
+//
+// Telemetry
+//
+// The count, execution latency, gas used, log count and gas refund of the executed transactions are
+// kept on the transient store, and emitted as telemetry metrics by the message server once the
+// cosmos transaction succeeds. They are labeled by transaction type, execution kind (call or create)
+// and status (successful or reverted). Their gas used and reverted count are also accumulated on the
+// transient store, in order to emit the aggregates of the block on EndBlock. The metrics aren't
+// recorded when the transaction is simulated on CheckTx state.
 func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+	start := time.Now()
 	ctx := k.Ctx()
 	params := k.GetParams(ctx)
 
@@ -145,7 +192,8 @@ func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumT
 	// get the latest signer according to the chain rules from the config
 	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
 
-	msg, err := tx.AsMessage(signer)
+	// the gas price of the message is the effective gas price at which the fees were charged
+	msg, err := tx.AsMessage(signer, k.GetBaseFee(ctx))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "failed to return ethereum transaction as core message")
 	}
@@ -165,12 +213,16 @@ func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumT
 	// set the transaction hash and index to the impermanent (transient) block state so that it's also
 	// available on the StateDB functions (eg: AddLog)
 	k.SetTxHashTransient(txHash)
-	k.IncreaseTxIndexTransient()
 
 	if !k.ctxStack.IsEmpty() {
 		panic("context stack shouldn't be dirty before apply message")
 	}
 
+	// the pre processing hooks can reject the transaction before its execution
+	if err := k.PreTxProcessing(msg); err != nil {
+		return nil, stacktrace.Propagate(sdkerrors.Wrap(types.ErrTxRejected, err.Error()), "failed to pre process transaction")
+	}
+
 	var revision int
 	if k.hooks != nil {
 		// snapshot to contain the tx processing and post processing in same scope
@@ -178,7 +230,7 @@ func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumT
 	}
 
 	// pass false to execute in real mode, which do actual gas refunding
-	res, err := k.ApplyMessage(evm, msg, ethCfg, false)
+	res, refund, err := k.applyMessage(evm, msg, ethCfg, false)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "failed to apply ethereum core message")
 	}
@@ -186,11 +238,15 @@ func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumT
 	res.Hash = txHash.Hex()
 	logs := k.GetTxLogsTransient(txHash)
 
+	// the snapshot can only be reverted once, as the revert discards the snapshots taken after it
+	reverted := false
+
 	if !res.Failed() {
 		// Only call hooks if tx executed successfully.
 		if err = k.PostTxProcessing(txHash, logs); err != nil {
 			// If hooks return error, revert the whole tx.
 			k.RevertToSnapshot(revision)
+			reverted = true
 			res.VmError = types.ErrPostTxProcessing.Error()
 			k.Logger(ctx).Error("tx post processing failed", "error", err)
 		}
@@ -204,12 +260,38 @@ func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumT
 		k.SetBlockBloomTransient(bloom)
 	}
 
+	// the post execution hooks are called for the failed transactions as well
+	if err = k.PostTxExecution(msg, res); err != nil {
+		// If hooks return error, revert the whole tx.
+		if !reverted {
+			k.RevertToSnapshot(revision)
+		}
+		res.VmError = types.ErrPostTxProcessing.Error()
+		k.Logger(ctx).Error("tx post execution failed", "error", err)
+	}
+
 	// Since we've implemented `RevertToSnapshot` api, so for the vm error cases,
 	// the state is reverted, so it's ok to call the commit here anyway.
 	k.CommitCachedContexts()
 
+	// transfer the base fee part of the fees paid for the gas used to its destination
+	if err := k.HandleBaseFee(ctx, k.GetBaseFee(ctx), res.GasUsed); err != nil {
+		return nil, stacktrace.Propagate(err, "failed to handle the base fee of the transaction")
+	}
+
+	k.IncreaseTxIndexTransient()
+
+	// update the block aggregates and record the transaction metrics
+	k.AddBlockGasUsedTransient(res.GasUsed)
+	if res.Failed() {
+		k.IncreaseBlockTxsRevertedTransient()
+	}
+	if !ctx.IsCheckTx() {
+		k.addTxMetricsTransient(ctx, newTxMetrics(tx, res, refund, time.Since(start)))
+	}
+
 	// update the gas used after refund
-	k.resetGasMeterAndConsumeGas(res.GasUsed)
+	k.resetGasMeterAndConsumeGas(k.AddGasUsedTransient(res.GasUsed))
 	return res, nil
 }
 
@@ -243,6 +325,13 @@ func (k *Keeper) ApplyTransaction(tx *ethtypes.Transaction) (*types.MsgEthereumT
 // The gRPC query endpoint from 'eth_call' calls this method in query mode, and since the query handler don't call AnteHandler,
 // so we don't do real gas refund in that case.
 func (k *Keeper) ApplyMessage(evm *vm.EVM, msg core.Message, cfg *params.ChainConfig, query bool) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessage(evm, msg, cfg, query)
+	return res, err
+}
+
+// applyMessage applies the given message as ApplyMessage does, and also returns the gas refunded to
+// the sender, which is zero in query mode.
+func (k *Keeper) applyMessage(evm *vm.EVM, msg core.Message, cfg *params.ChainConfig, query bool) (*types.MsgEthereumTxResponse, uint64, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
@@ -254,29 +343,59 @@ func (k *Keeper) ApplyMessage(evm *vm.EVM, msg core.Message, cfg *params.ChainCo
 	intrinsicGas, err := k.GetEthIntrinsicGas(msg, cfg, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, stacktrace.Propagate(err, "intrinsic gas failed")
+		return nil, 0, stacktrace.Propagate(err, "intrinsic gas failed")
 	}
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if msg.Gas() < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, stacktrace.Propagate(core.ErrIntrinsicGas, "apply message")
+		return nil, 0, stacktrace.Propagate(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas := msg.Gas() - intrinsicGas
 
 	// access list preparaion is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.Rules(big.NewInt(k.Ctx().BlockHeight())); rules.IsBerlin {
-		k.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
+	rules := cfg.Rules(big.NewInt(k.Ctx().BlockHeight()))
+	if rules.IsBerlin {
+		k.PrepareAccessList(msg.From(), msg.To(), k.ActivePrecompiles(rules, k.GetParams(k.Ctx())), msg.AccessList())
+	}
+
+	// snapshot to revert the message if it violates the contract deployment or call permissions
+	var (
+		perms    *permissions
+		revision int
+		nonce    uint64
+	)
+	if hook, ok := evm.StateDB.(*evmHook); ok && hook.permissions != nil {
+		perms = hook.permissions
+		revision = k.Snapshot()
+		nonce = k.GetNonce(msg.From())
 	}
 
-	if contractCreation {
+	switch {
+	case perms != nil && !perms.checkMessage(msg.From(), msg.To()):
+		// the message violating the permissions isn't executed
+	case contractCreation:
 		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
-	} else {
+	default:
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
-	refundQuotient := uint64(2)
+	if perms != nil && perms.err != nil {
+		k.RevertToSnapshot(revision)
+		if contractCreation {
+			// the nonce incremented by the contract creation is kept, as on the failed creations
+			k.SetNonce(msg.From(), nonce+1)
+		}
+		ret, vmErr = nil, perms.err
+	}
+
+	// the gas refund is capped to a fifth of the gas used after London (EIP-3529)
+	refundQuotient := params.RefundQuotient
+	if rules.IsLondon {
+		refundQuotient = params.RefundQuotientEIP3529
+	}
 
+	var refund uint64
 	if query {
 		// gRPC query handlers don't go through the AnteHandler to deduct the gas fee from the sender or have access historical state.
 		// We don't refund gas to the sender.
@@ -285,10 +404,12 @@ func (k *Keeper) ApplyMessage(evm *vm.EVM, msg core.Message, cfg *params.ChainCo
 		leftoverGas += k.GasToRefund(gasConsumed, refundQuotient)
 	} else {
 		// refund gas prior to handling the vm error in order to match the Ethereum gas consumption instead of the default SDK one.
+		unrefundedGas := leftoverGas
 		leftoverGas, err = k.RefundGas(msg, leftoverGas, refundQuotient)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "failed to refund gas leftover gas to sender %s", msg.From())
+			return nil, 0, stacktrace.Propagate(err, "failed to refund gas leftover gas to sender %s", msg.From())
 		}
+		refund = leftoverGas - unrefundedGas
 	}
 
 	// EVM execution error needs to be available for the JSON-RPC client
@@ -302,7 +423,55 @@ func (k *Keeper) ApplyMessage(evm *vm.EVM, msg core.Message, cfg *params.ChainCo
 		GasUsed: gasUsed,
 		VmError: vmError,
 		Ret:     ret,
-	}, nil
+	}, refund, nil
+}
+
+// CallEVM applies a message with the given sender, recipient and data to the EVM, outside of an
+// Ethereum transaction. It allows other modules to call contracts, or to deploy them if the
+// recipient is nil. No value is transferred and no fees are paid. A failed execution returns an
+// error, with the revert reason if any. The state changes are written to the given context only if
+// commit is true.
+//
+// CallEVM runs on a copy of the keeper, so that it can be called from the EVM hooks without
+// affecting the context stack of the transaction being processed.
+func (k Keeper) CallEVM(
+	ctx sdk.Context, from common.Address, to *common.Address, data []byte, gasLimit uint64, commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	k.WithContext(ctx)
+
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+
+	coinbase, err := k.GetCoinbaseAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := ethtypes.NewMessage(from, to, k.GetNonce(from), big.NewInt(0), gasLimit, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, false)
+	evm := k.NewEVM(msg, ethCfg, params, coinbase, nil)
+
+	// pass true to skip the gas refund, as no fees are paid
+	res, err := k.ApplyMessage(evm, msg, ethCfg, true)
+	if err != nil {
+		k.ctxStack.RevertAll()
+		return nil, stacktrace.Propagate(err, "failed to apply message")
+	}
+
+	if res.Failed() {
+		k.ctxStack.RevertAll()
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			return res, types.NewExecErrorWithReason(res.Ret)
+		}
+		return res, sdkerrors.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	if commit {
+		k.CommitCachedContexts()
+	} else {
+		k.ctxStack.RevertAll()
+	}
+
+	return res, nil
 }
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
@@ -326,8 +495,8 @@ func (k *Keeper) GasToRefund(gasConsumed, refundQuotient uint64) uint64 {
 	return refund
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// RefundGas transfers the leftover gas to the fee payer of the message, i.e. the sender or the fee
+// granter of the transaction, capped by the refund quotient of the total gas consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(msg core.Message, leftoverGas, refundQuotient uint64) (uint64, error) {
@@ -353,7 +522,8 @@ func (k *Keeper) RefundGas(msg core.Message, leftoverGas, refundQuotient uint64)
 		)
 	}
 
-	// Return EVM tokens for remaining gas, exchanged at the original rate.
+	// Return EVM tokens for remaining gas, exchanged at the original rate. The gas price of the
+	// message is the effective gas price of the transaction, at which the fees were charged.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
 	switch remaining.Sign() {
@@ -365,9 +535,13 @@ func (k *Keeper) RefundGas(msg core.Message, leftoverGas, refundQuotient uint64)
 		params := k.GetParams(k.Ctx())
 		refundedCoins := sdk.Coins{sdk.NewCoin(params.EvmDenom, sdk.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		feePayer := k.GetFeePayerTransient(k.GetTxHashTransient())
+		if feePayer == nil {
+			feePayer = msg.From().Bytes()
+		}
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(k.Ctx(), authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(k.Ctx(), authtypes.FeeCollectorName, feePayer, refundedCoins)
 		if err != nil {
 			err = sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return leftoverGas, stacktrace.Propagate(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/palantir/stacktrace"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -14,6 +18,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm/types"
 )
 
 func (suite *KeeperTestSuite) TestGetHashFn() {
@@ -173,3 +178,72 @@ func (suite *KeeperTestSuite) TestGetCoinbaseAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestApplyMultipleTransactions() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+
+	// the ethereum transactions of the same cosmos transaction are executed in order
+	rsp1 := suite.sendTx(tests.GenerateAddress(), nil, 100000)
+	rsp2 := suite.sendTx(tests.GenerateAddress(), nil, 100000)
+	suite.Require().False(rsp1.Failed(), rsp1.VmError)
+	suite.Require().False(rsp2.Failed(), rsp2.VmError)
+
+	// the gas used by the cosmos transaction is the sum of the gas used by the ethereum transactions
+	suite.Require().Equal(rsp1.GasUsed+rsp2.GasUsed, suite.ctx.GasMeter().GasConsumed())
+
+	// each ethereum transaction is assigned the next index of the block
+	suite.Require().Equal(uint64(2), suite.app.EvmKeeper.GetTxIndexTransient())
+
+	var indexes []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEthereumTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyTxIndex {
+				indexes = append(indexes, string(attr.Value))
+			}
+		}
+	}
+	suite.Require().Equal([]string{"0", "1"}, indexes)
+}
+
+func (suite *KeeperTestSuite) TestApplyMultipleTransactionsAtomic() {
+	testCases := []struct {
+		name    string
+		msgs    uint64
+		expPass bool
+	}{
+		{"single message reverted", 1, true},
+		{"message of a multi-message transaction reverted", 2, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetTxMsgsTransient(suite.ctx, tc.msgs)
+
+			reverter := tests.GenerateAddress()
+			suite.app.EvmKeeper.SetCode(reverter, []byte{
+				byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.REVERT),
+			})
+
+			chainID := suite.app.EvmKeeper.ChainID()
+			tx := types.NewTx(chainID, 0, &reverter, nil, 100000, nil, nil, nil)
+			tx.From = suite.address.Hex()
+			suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			if tc.expPass {
+				// the reverted message has a failed receipt
+				suite.Require().NoError(err)
+				suite.Require().True(rsp.Failed())
+			} else {
+				// the reverted message fails the whole cosmos transaction
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(stacktrace.RootCause(err), types.ErrVMExecution), err.Error())
+			}
+		})
+	}
+}
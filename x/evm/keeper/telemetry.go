@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// telemetry labels of the ethereum transaction metrics
+const (
+	labelTxType    = "tx_type"
+	labelExecution = "execution"
+	labelStatus    = "status"
+
+	executionCall   = "call"
+	executionCreate = "create"
+
+	statusSuccessful = "successful"
+	statusReverted   = "reverted"
+)
+
+// txMetrics are the metrics of an executed ethereum transaction. They are kept on the transient
+// store until the cosmos transaction containing it succeeds, as a failed message discards the state
+// changes of the whole cosmos transaction.
+type txMetrics struct {
+	TxType  uint8         `json:"tx_type"`
+	Create  bool          `json:"create"`
+	Failed  bool          `json:"failed"`
+	GasUsed uint64        `json:"gas_used"`
+	Logs    int           `json:"logs"`
+	Refund  uint64        `json:"refund"`
+	Latency time.Duration `json:"latency"`
+}
+
+// newTxMetrics returns the metrics of the given executed ethereum transaction.
+func newTxMetrics(tx *ethtypes.Transaction, res *types.MsgEthereumTxResponse, refund uint64, latency time.Duration) txMetrics {
+	return txMetrics{
+		TxType:  tx.Type(),
+		Create:  tx.To() == nil,
+		Failed:  res.Failed(),
+		GasUsed: res.GasUsed,
+		Logs:    len(res.Logs),
+		Refund:  refund,
+		Latency: latency,
+	}
+}
+
+// labels returns the labels of the metrics of the transaction: its type, whether it is a call or a
+// contract creation, and whether its execution succeeded or was reverted.
+func (m txMetrics) labels() []metrics.Label {
+	execution := executionCall
+	if m.Create {
+		execution = executionCreate
+	}
+
+	status := statusSuccessful
+	if m.Failed {
+		status = statusReverted
+	}
+
+	return []metrics.Label{
+		telemetry.NewLabel(labelTxType, strconv.FormatUint(uint64(m.TxType), 10)),
+		telemetry.NewLabel(labelExecution, execution),
+		telemetry.NewLabel(labelStatus, status),
+	}
+}
+
+// emit emits the metrics of the transaction: its count, execution latency, gas used and log count,
+// the contract creation count if it deployed a contract, and the gas refunded to its sender.
+func (m txMetrics) emit() {
+	labels := m.labels()
+
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "tx", "total"}, 1, labels)
+	metrics.AddSampleWithLabels([]string{types.ModuleName, "tx", "latency"}, float32(m.Latency)/float32(time.Millisecond), labels)
+	metrics.AddSampleWithLabels([]string{types.ModuleName, "tx", "gas_used"}, float32(m.GasUsed), labels)
+
+	if m.Logs > 0 {
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, "tx", "logs"}, float32(m.Logs), labels)
+	}
+
+	if m.Create && !m.Failed {
+		telemetry.IncrCounter(1, types.ModuleName, "contract", "creations")
+	}
+
+	if m.Refund > 0 {
+		telemetry.IncrCounter(float32(m.Refund), types.ModuleName, "tx", "gas_refunded")
+		metrics.AddSample([]string{types.ModuleName, "tx", "gas_refund"}, float32(m.Refund))
+	}
+}
+
+// getTxMetricsTransient returns the metrics of the ethereum transactions of the current cosmos
+// transaction that weren't emitted yet.
+func (k Keeper) getTxMetricsTransient(ctx sdk.Context) []txMetrics {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientTxMetrics)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var txs []txMetrics
+	if err := json.Unmarshal(bz, &txs); err != nil {
+		panic(err)
+	}
+	return txs
+}
+
+// addTxMetricsTransient adds the metrics of an executed ethereum transaction to the ones of the
+// current cosmos transaction.
+func (k Keeper) addTxMetricsTransient(ctx sdk.Context, m txMetrics) {
+	bz, err := json.Marshal(append(k.getTxMetricsTransient(ctx), m))
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientTxMetrics, bz)
+}
+
+// emitTxMetrics emits the metrics of the ethereum transactions of the current cosmos transaction
+// once all of its messages are executed, and removes them from the transient store. A cosmos
+// transaction can't fail after its last message succeeds. The metrics of the simulated cosmos
+// transactions are not emitted.
+func (k Keeper) emitTxMetrics(ctx sdk.Context) {
+	// the gas of the cosmos transaction is already set to the gas used by its ethereum transactions
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := ctx.TransientStore(k.transientKey)
+
+	if k.IsSimulationTransient(ctx) {
+		return
+	}
+
+	txs := k.getTxMetricsTransient(ctx)
+	if uint64(len(txs)) < sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxMsgs)) {
+		return
+	}
+
+	for _, m := range txs {
+		m.emit()
+	}
+
+	store.Delete(types.KeyPrefixTransientTxMetrics)
+}
+
+// EmitIntrinsicGasFailureMetrics counts the ethereum transactions rejected because their gas limit
+// doesn't cover their intrinsic gas, or because the intrinsic gas can't be computed.
+func EmitIntrinsicGasFailureMetrics() {
+	telemetry.IncrCounter(1, types.ModuleName, "tx", "intrinsic_gas_failures")
+}
+
+// emitBlockMetrics emits the aggregates of the ethereum transactions executed in the current block:
+// their count, the number of reverted transactions, the total gas used and the number of logs.
+func (k Keeper) emitBlockMetrics() {
+	txs := k.GetTxIndexTransient()
+	reverted := k.GetBlockTxsRevertedTransient()
+
+	telemetry.SetGauge(float32(txs), types.ModuleName, "block", "txs")
+	telemetry.SetGauge(float32(txs-reverted), types.ModuleName, "block", "txs_successful")
+	telemetry.SetGauge(float32(reverted), types.ModuleName, "block", "txs_reverted")
+	telemetry.SetGauge(float32(k.GetBlockGasUsedTransient()), types.ModuleName, "block", "gas_used")
+	telemetry.SetGauge(float32(k.GetLogSizeTransient()), types.ModuleName, "block", "logs")
+}
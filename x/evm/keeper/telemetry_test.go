@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestBlockMetricsTransient() {
+	suite.SetupTest()
+
+	// fees paid to the fee collector by the ante handler
+	fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(200000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, fees))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	suite.app.EvmKeeper.AddBalance(suite.address, big.NewInt(100))
+	suite.Require().Zero(suite.app.EvmKeeper.GetBlockGasUsedTransient())
+	suite.Require().Zero(suite.app.EvmKeeper.GetBlockTxsRevertedTransient())
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	amounts := []*big.Int{
+		big.NewInt(1),
+		// the transfer of more than the balance of the sender, including the refunds, is reverted
+		big.NewInt(1000000000),
+	}
+
+	var gasUsed uint64
+	for i, amount := range amounts {
+		tx := evmtypes.NewTx(chainID, uint64(i), &common.Address{}, amount, 100000, big.NewInt(1), nil, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+		rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(i == 1, rsp.Failed(), rsp.VmError)
+		gasUsed += rsp.GasUsed
+	}
+
+	suite.Require().Equal(uint64(2), suite.app.EvmKeeper.GetTxIndexTransient())
+	suite.Require().Equal(gasUsed, suite.app.EvmKeeper.GetBlockGasUsedTransient())
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetBlockTxsRevertedTransient())
+}
+
+// useInmemMetrics sets an in-memory sink as the global metrics sink, until the end of the test.
+func (suite *KeeperTestSuite) useInmemMetrics() *metrics.InmemSink {
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	_, err := metrics.NewGlobal(cfg, sink)
+	suite.Require().NoError(err)
+
+	suite.T().Cleanup(func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	})
+	return sink
+}
+
+// txTotal returns the number of ethereum transactions counted by the given sink.
+func txTotal(sink *metrics.InmemSink) int {
+	total := 0
+	for _, interval := range sink.Data() {
+		for key, counter := range interval.Counters {
+			if strings.HasPrefix(key, "evm.tx.total") {
+				total += counter.Count
+			}
+		}
+	}
+	return total
+}
+
+func (suite *KeeperTestSuite) TestTxMetricsEmittedOnSuccess() {
+	suite.SetupTest()
+	sink := suite.useInmemMetrics()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	ethereumTx := func(ctx sdk.Context, nonce uint64, amount *big.Int) error {
+		tx := evmtypes.NewTx(chainID, nonce, &common.Address{}, amount, 100000, big.NewInt(1), nil, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+		_, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(ctx), tx)
+		return err
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(300000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, fees))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	suite.app.EvmKeeper.AddBalance(suite.address, big.NewInt(100))
+
+	// the metrics of a cosmos transaction whose second message reverts are discarded along with it
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.app.EvmKeeper.SetTxMsgsTransient(cacheCtx, 2)
+	suite.Require().NoError(ethereumTx(cacheCtx, 0, big.NewInt(1)))
+	suite.Require().Error(ethereumTx(cacheCtx, 1, big.NewInt(1000000000)))
+	suite.Require().Zero(txTotal(sink))
+
+	// the metrics of a simulated cosmos transaction are never emitted
+	cacheCtx, _ = suite.ctx.CacheContext()
+	suite.app.EvmKeeper.SetTxMsgsTransient(cacheCtx, 1)
+	suite.app.EvmKeeper.SetSimulationTransient(cacheCtx, true)
+	suite.Require().NoError(ethereumTx(cacheCtx, 0, big.NewInt(1)))
+	suite.Require().Zero(txTotal(sink))
+
+	// the metrics are emitted once the last message of the cosmos transaction succeeds
+	suite.app.EvmKeeper.SetTxMsgsTransient(suite.ctx, 2)
+	suite.Require().NoError(ethereumTx(suite.ctx, 0, big.NewInt(1)))
+	suite.Require().Zero(txTotal(sink))
+	suite.Require().NoError(ethereumTx(suite.ctx, 1, big.NewInt(1)))
+	suite.Require().Equal(2, txTotal(sink))
+
+	suite.app.EvmKeeper.SetTxMsgsTransient(suite.ctx, 1)
+	suite.Require().NoError(ethereumTx(suite.ctx, 2, big.NewInt(1)))
+	suite.Require().Equal(3, txTotal(sink))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -21,17 +23,59 @@ func DeductTxCostsFromUserBalance(
 	msgEthTx evmtypes.MsgEthereumTx,
 	txData evmtypes.TxData,
 	denom string,
+	baseFee *big.Int,
 	homestead bool,
 	istanbul bool,
 ) (sdk.Coins, error) {
-	isContractCreation := txData.GetTo() == nil
+	return DeductTxCostsFromFeePayer(ctx, bankKeeper, accountKeeper, msgEthTx.GetFrom(), msgEthTx, txData, denom, baseFee, homestead, istanbul)
+}
 
-	// fetch sender account from signature
-	signerAcc, err := authante.GetSignerAcc(ctx, accountKeeper, msgEthTx.GetFrom())
+// DeductTxCostsFromFeePayer calculates the tx costs and deducts the fees from the balance of the
+// given fee payer, i.e. the sender or the fee granter of the transaction. The fees are charged at
+// the effective gas price of the transaction for the given EIP-1559 base fee, which is nil if the
+// base fee is not enforced.
+func DeductTxCostsFromFeePayer(
+	ctx sdk.Context,
+	bankKeeper evmtypes.BankKeeper,
+	accountKeeper evmtypes.AccountKeeper,
+	feePayer sdk.AccAddress,
+	msgEthTx evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	denom string,
+	baseFee *big.Int,
+	homestead bool,
+	istanbul bool,
+) (sdk.Coins, error) {
+	// fetch fee payer account
+	feePayerAcc, err := authante.GetSignerAcc(ctx, accountKeeper, feePayer)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "account not found for sender %s", msgEthTx.From)
+		return nil, stacktrace.Propagate(err, "account not found for fee payer %s", feePayer)
+	}
+
+	if err := CheckIntrinsicGas(ctx, txData, homestead, istanbul); err != nil {
+		return nil, err
+	}
+
+	// calculate the fees paid to validators based on gas limit and price
+	feeAmt := evmtypes.EffectiveFee(txData, baseFee) // fee = gas limit * effective gas price
+
+	fees := sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(feeAmt))}
+
+	// deduct the full gas cost from the fee payer balance
+	if err := authante.DeductFees(bankKeeper, ctx, feePayerAcc, fees); err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"failed to deduct full gas cost %s from the fee payer %s balance",
+			fees, feePayer,
+		)
 	}
+	return fees, nil
+}
 
+// CheckIntrinsicGas computes the intrinsic gas cost of the transaction and, during CheckTx, verifies
+// that its gas limit covers it.
+func CheckIntrinsicGas(ctx sdk.Context, txData evmtypes.TxData, homestead, istanbul bool) error {
+	isContractCreation := txData.GetTo() == nil
 	gasLimit := txData.GetGas()
 
 	var accessList ethtypes.AccessList
@@ -41,7 +85,7 @@ func DeductTxCostsFromUserBalance(
 
 	intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul)
 	if err != nil {
-		return nil, stacktrace.Propagate(sdkerrors.Wrap(
+		return stacktrace.Propagate(sdkerrors.Wrap(
 			err,
 			"failed to compute intrinsic gas cost"), "failed to retrieve intrinsic gas, contract creation = %t; homestead = %t, istanbul = %t",
 			isContractCreation, homestead, istanbul,
@@ -50,26 +94,13 @@ func DeductTxCostsFromUserBalance(
 
 	// intrinsic gas verification during CheckTx
 	if ctx.IsCheckTx() && gasLimit < intrinsicGas {
-		return nil, sdkerrors.Wrapf(
+		return sdkerrors.Wrapf(
 			sdkerrors.ErrOutOfGas,
 			"gas limit too low: %d (gas limit) < %d (intrinsic gas)", gasLimit, intrinsicGas,
 		)
 	}
 
-	// calculate the fees paid to validators based on gas limit and price
-	feeAmt := txData.Fee() // fee = gas limit * gas price
-
-	fees := sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(feeAmt))}
-
-	// deduct the full gas cost from the user balance
-	if err := authante.DeductFees(bankKeeper, ctx, signerAcc, fees); err != nil {
-		return nil, stacktrace.Propagate(
-			err,
-			"failed to deduct full gas cost %s from the user %s balance",
-			fees, msgEthTx.From,
-		)
-	}
-	return fees, nil
+	return nil
 }
 
 // CheckSenderBalance validates sender has enough funds to pay for tx cost
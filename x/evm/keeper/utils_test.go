@@ -4,8 +4,10 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/tharsis/ethermint/tests"
 	evmkeeper "github.com/tharsis/ethermint/x/evm/keeper"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
@@ -231,6 +233,7 @@ func (suite *KeeperTestSuite) TestDeductTxCostsFromUserBalance() {
 				*tx,
 				txData,
 				evmtypes.DefaultEVMDenom,
+				nil,
 				false,
 				false,
 			)
@@ -252,3 +255,44 @@ func (suite *KeeperTestSuite) TestDeductTxCostsFromUserBalance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRefundGasToFeePayer() {
+	testCases := []struct {
+		name     string
+		feePayer func() sdk.AccAddress
+	}{
+		{"sender", func() sdk.AccAddress { return nil }},
+		{"fee granter", func() sdk.AccAddress { return tests.GenerateAddress().Bytes() }},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// fees paid to the fee collector by the ante handler
+			fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewInt(100000)))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, fees))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+			chainID := suite.app.EvmKeeper.ChainID()
+			tx := evmtypes.NewTx(chainID, 0, &common.Address{}, nil, 100000, big.NewInt(1), nil, nil)
+			tx.From = suite.address.Hex()
+			suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			feePayer := tc.feePayer()
+			if feePayer != nil {
+				suite.app.EvmKeeper.SetFeePayerTransient(suite.ctx, tx.AsTransaction().Hash(), feePayer)
+			} else {
+				feePayer = suite.address.Bytes()
+			}
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, feePayer, evmtypes.DefaultEVMDenom)
+
+			rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			suite.Require().NoError(err)
+			suite.Require().False(rsp.Failed(), rsp.VmError)
+
+			refund := sdk.NewIntFromUint64(100000 - rsp.GasUsed)
+			suite.Require().Equal(balance.Amount.Add(refund), suite.app.BankKeeper.GetBalance(suite.ctx, feePayer, evmtypes.DefaultEVMDenom).Amount)
+		})
+	}
+}
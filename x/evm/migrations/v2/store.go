@@ -0,0 +1,26 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// MigrateStore migrates the EVM params from version 1 to 2, setting the ActivePrecompiles,
+// BankPrecompileGas, AllowedDeployers, CallBlocklists and StakingPrecompileGas params added in
+// version 2 to their default values, i.e. no active precompiles, the default precompile gas costs
+// and no deployment or call restrictions.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	paramSpace.Set(ctx, types.ParamStoreKeyActivePrecompiles, defaultParams.ActivePrecompiles)
+	paramSpace.Set(ctx, types.ParamStoreKeyBankPrecompileGas, defaultParams.BankPrecompileGas)
+	paramSpace.Set(ctx, types.ParamStoreKeyAllowedDeployers, defaultParams.AllowedDeployers)
+	paramSpace.Set(ctx, types.ParamStoreKeyCallBlocklists, defaultParams.CallBlocklists)
+	paramSpace.Set(ctx, types.ParamStoreKeyStakingPrecompileGas, defaultParams.StakingPrecompileGas)
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	return params.Validate()
+}
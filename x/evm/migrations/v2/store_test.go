@@ -0,0 +1,45 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2 "github.com/tharsis/ethermint/x/evm/migrations/v2"
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// params of version 1
+	chainConfig := types.DefaultChainConfig()
+	paramSpace.Set(ctx, types.ParamStoreKeyEVMDenom, "aphoton")
+	paramSpace.Set(ctx, types.ParamStoreKeyEnableCreate, false)
+	paramSpace.Set(ctx, types.ParamStoreKeyEnableCall, true)
+	paramSpace.Set(ctx, types.ParamStoreKeyExtraEIPs, []int64{2200})
+	paramSpace.Set(ctx, types.ParamStoreKeyChainConfig, chainConfig)
+
+	var params types.Params
+	require.Panics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+
+	require.NoError(t, v2.MigrateStore(ctx, paramSpace))
+
+	paramSpace.GetParamSet(ctx, &params)
+	expParams := types.NewParams("aphoton", false, true, chainConfig, 2200)
+	expParams.BankPrecompileGas = types.DefaultBankPrecompileGas()
+	expParams.StakingPrecompileGas = types.DefaultStakingPrecompileGas()
+	require.Equal(t, expParams, params)
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity >=0.8.0;
+
+/// @dev The bank precompiled contract address.
+address constant BANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000800;
+
+/// @title Bank precompiled contract
+/// @notice Interface of the precompiled contract exposing the balances of the native Cosmos coins.
+interface IBank {
+    /// @dev Emitted when coins are transferred with the transfer method.
+    event Transfer(address indexed from, address indexed to, string denom, uint256 amount);
+
+    /// @dev Returns the balance of the given denomination held by the account.
+    function balanceOf(address account, string calldata denom) external view returns (uint256);
+
+    /// @dev Returns the total supply of the given denomination.
+    function totalSupply(string calldata denom) external view returns (uint256);
+
+    /// @dev Transfers the given amount of coins from the caller to the recipient.
+    function transfer(address to, string calldata denom, uint256 amount) external returns (bool);
+}
@@ -0,0 +1,212 @@
+// Package bank implements the bank precompiled contract, which exposes the balances and the total
+// supply of the native Cosmos coins to the EVM, and allows the contracts and accounts to transfer
+// them without wrapping. The Solidity interface of the precompile is defined in IBank.sol.
+package bank
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const (
+	// BalanceOfMethod defines the ABI method name of the balance query
+	BalanceOfMethod = "balanceOf"
+	// TotalSupplyMethod defines the ABI method name of the total supply query
+	TotalSupplyMethod = "totalSupply"
+	// TransferMethod defines the ABI method name of the coins transfer
+	TransferMethod = "transfer"
+	// TransferEvent defines the ABI event name emitted on coins transfers
+	TransferEvent = "Transfer"
+)
+
+var (
+	// Address is the reserved address of the bank precompiled contract
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000800")
+
+	//go:embed abi.json
+	abiJSON []byte
+	// ABI is the ABI of the bank precompiled contract
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// BankKeeper defines the expected bank keeper interface of the precompile
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// EVMKeeper defines the expected EVM keeper interface of the precompile
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// Precompile is the bank precompiled contract. The gas costs of its methods are defined by the
+// BankPrecompileGas parameter of the EVM module.
+type Precompile struct {
+	bankKeeper BankKeeper
+	evmKeeper  EVMKeeper
+}
+
+// NewPrecompile creates a new bank precompiled contract
+func NewPrecompile(bankKeeper BankKeeper, evmKeeper EVMKeeper) Precompile {
+	return Precompile{
+		bankKeeper: bankKeeper,
+		evmKeeper:  evmKeeper,
+	}
+}
+
+// Address implements StatefulPrecompiledContract
+func (p Precompile) Address() common.Address {
+	return Address
+}
+
+// RequiredGas implements StatefulPrecompiledContract. It returns the gas cost of the called method,
+// or zero if the method is unknown, as the call fails anyway.
+func (p Precompile) RequiredGas(ctx sdk.Context, input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	gas := p.evmKeeper.GetParams(ctx).BankPrecompileGas
+	switch method.Name {
+	case BalanceOfMethod:
+		return gas.BalanceOf
+	case TotalSupplyMethod:
+		return gas.TotalSupply
+	case TransferMethod:
+		return gas.Transfer
+	default:
+		return 0
+	}
+}
+
+// Run implements StatefulPrecompiledContract
+func (p Precompile) Run(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, errors.New("invalid input length")
+	}
+
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.Value().Sign() > 0 {
+		return nil, fmt.Errorf("method %s is not payable", method.Name)
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case BalanceOfMethod:
+		return p.balanceOf(ctx, method, args)
+	case TotalSupplyMethod:
+		return p.totalSupply(ctx, method, args)
+	case TransferMethod:
+		if readOnly {
+			return nil, vm.ErrWriteProtection
+		}
+		return p.transfer(ctx, evm, contract, method, args)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+func (p Precompile) balanceOf(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	account, _ := args[0].(common.Address)
+	denom, _ := args[1].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+
+	balance := p.bankKeeper.GetBalance(ctx, sdk.AccAddress(account.Bytes()), denom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+func (p Precompile) totalSupply(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, _ := args[0].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+
+	supply := p.bankKeeper.GetSupply(ctx, denom)
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// transfer sends the coins from the caller to the recipient, with the same checks as the bank
+// MsgSend, and emits the Transfer event.
+func (p Precompile) transfer(
+	ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{},
+) ([]byte, error) {
+	to, _ := args[0].(common.Address)
+	denom, _ := args[1].(string)
+	amount, _ := args[2].(*big.Int)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+	coin := sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount))
+
+	if coin.IsPositive() {
+		if err := p.bankKeeper.IsSendEnabledCoins(ctx, coin); err != nil {
+			return nil, err
+		}
+
+		recipient := sdk.AccAddress(to.Bytes())
+		if p.bankKeeper.BlockedAddr(recipient) {
+			return nil, fmt.Errorf("%s is not allowed to receive funds", to)
+		}
+
+		if err := p.bankKeeper.SendCoins(ctx, sdk.AccAddress(from.Bytes()), recipient, sdk.Coins{coin}); err != nil {
+			return nil, err
+		}
+	}
+
+	event := ABI.Events[TransferEvent]
+	data, err := event.Inputs.NonIndexed().Pack(denom, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      []common.Hash{event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:        data,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,245 @@
+package bank_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm/precompiles/bank"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.EthermintApp
+	address common.Address
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{bank.Address.Hex()}
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	suite.address = tests.GenerateAddress()
+	suite.mint(suite.address, 1000)
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) mint(address common.Address, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, evmtypes.ModuleName, address.Bytes(), coins))
+}
+
+func (suite *PrecompileTestSuite) balance(address common.Address) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, address.Bytes(), denom).Amount.Int64()
+}
+
+// call applies a message from the given sender and commits its state changes.
+func (suite *PrecompileTestSuite) call(from, to common.Address, data []byte) *evmtypes.MsgEthereumTxResponse {
+	k := suite.app.EvmKeeper
+	k.WithContext(suite.ctx)
+
+	params := k.GetParams(suite.ctx)
+	cfg := params.ChainConfig.EthereumConfig(k.ChainID())
+
+	msg := ethtypes.NewMessage(from, &to, k.GetNonce(from), big.NewInt(0), 1000000, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, false)
+	evm := k.NewEVM(msg, cfg, params, common.Address{}, nil)
+
+	res, err := k.ApplyMessage(evm, msg, cfg, false)
+	suite.Require().NoError(err)
+	if !res.Failed() {
+		k.CommitCachedContexts()
+	}
+	res.Logs = evmtypes.NewLogsFromEth(k.GetTxLogsTransient(common.Hash{}))
+	return res
+}
+
+// forwarderCode returns the code of a contract that forwards its call data to the bank
+// precompile with the given opcode, and returns or reverts with the success flag of the call.
+func forwarderCode(op vm.OpCode, revert bool) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0x00, // retSize
+		byte(vm.PUSH1), 0x00, // retOffset
+		byte(vm.CALLDATASIZE), // argsSize
+		byte(vm.PUSH1), 0x00,  // argsOffset
+	}
+	if op == vm.CALL {
+		code = append(code, byte(vm.PUSH1), 0x00) // value
+	}
+	code = append(code, byte(vm.PUSH20))
+	code = append(code, bank.Address.Bytes()...)
+	code = append(code,
+		byte(vm.GAS), byte(op),
+		byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00,
+	)
+	if revert {
+		return append(code, byte(vm.REVERT))
+	}
+	return append(code, byte(vm.RETURN))
+}
+
+func (suite *PrecompileTestSuite) TestBalanceOf() {
+	input, err := bank.ABI.Pack(bank.BalanceOfMethod, suite.address, denom)
+	suite.Require().NoError(err)
+
+	res := suite.call(suite.address, bank.Address, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1000)).Bytes(), res.Ret)
+
+	// gas used: intrinsic gas + call data + method gas + stub code gas
+	gas := evmtypes.DefaultBankPrecompileGas().BalanceOf
+	suite.Require().Greater(res.GasUsed, 21000+gas)
+
+	input, err = bank.ABI.Pack(bank.BalanceOfMethod, suite.address, "invalid denom")
+	suite.Require().NoError(err)
+	res = suite.call(suite.address, bank.Address, input)
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestTotalSupply() {
+	suite.mint(tests.GenerateAddress(), 500)
+
+	input, err := bank.ABI.Pack(bank.TotalSupplyMethod, denom)
+	suite.Require().NoError(err)
+
+	res := suite.call(suite.address, bank.Address, input)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1500)).Bytes(), res.Ret)
+}
+
+func (suite *PrecompileTestSuite) TestTransfer() {
+	var (
+		sender    common.Address
+		recipient common.Address
+		to        common.Address
+		amount    int64
+	)
+
+	deploy := func(code []byte) common.Address {
+		address := tests.GenerateAddress()
+		suite.app.EvmKeeper.SetCode(address, code)
+		return address
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"transfer from an account",
+			func() {},
+			true,
+		},
+		{
+			"zero amount",
+			func() {
+				amount = 0
+			},
+			true,
+		},
+		{
+			"insufficient funds",
+			func() {
+				amount = 1001
+			},
+			false,
+		},
+		{
+			"blocked recipient",
+			func() {
+				recipient = common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress(evmtypes.ModuleName))
+			},
+			false,
+		},
+		{
+			"transfer from a contract",
+			func() {
+				to = deploy(forwarderCode(vm.CALL, false))
+				sender = to
+				suite.mint(sender, 1000)
+			},
+			true,
+		},
+		{
+			"transfer from a contract reverted by the caller",
+			func() {
+				to = deploy(forwarderCode(vm.CALL, true))
+				sender = to
+				suite.mint(sender, 1000)
+			},
+			false,
+		},
+		{
+			"transfer from a static call",
+			func() {
+				to = deploy(forwarderCode(vm.STATICCALL, false))
+				sender = to
+				suite.mint(sender, 1000)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			sender = suite.address
+			recipient = tests.GenerateAddress()
+			to = bank.Address
+			amount = 100
+
+			tc.malleate()
+
+			input, err := bank.ABI.Pack(bank.TransferMethod, recipient, denom, big.NewInt(amount))
+			suite.Require().NoError(err)
+
+			res := suite.call(suite.address, to, input)
+
+			if tc.expPass {
+				suite.Require().Equal(1000-amount, suite.balance(sender))
+				suite.Require().Equal(amount, suite.balance(recipient))
+
+				suite.Require().Len(res.Logs, 1)
+				log := res.Logs[0]
+				suite.Require().Equal(bank.Address.Hex(), log.Address)
+				suite.Require().Equal(bank.ABI.Events[bank.TransferEvent].ID.Hex(), log.Topics[0])
+				suite.Require().Equal(common.BytesToHash(sender.Bytes()).Hex(), log.Topics[1])
+				suite.Require().Equal(common.BytesToHash(recipient.Bytes()).Hex(), log.Topics[2])
+			} else {
+				suite.Require().Equal(int64(1000), suite.balance(sender))
+				suite.Require().Zero(suite.balance(recipient))
+				suite.Require().Empty(res.Logs)
+			}
+		})
+	}
+}
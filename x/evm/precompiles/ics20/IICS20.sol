@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity >=0.8.0;
+
+/// @dev The ICS-20 precompiled contract address.
+address constant ICS20_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000802;
+
+/// @title ICS-20 precompiled contract
+/// @notice Interface of the precompiled contract sending ICS-20 fungible token transfers over IBC.
+/// The caller of the precompile acts as the sender of the transfer, and the tokens are escrowed or
+/// burned from its balance.
+///
+/// The acknowledgement or timeout of each transfer is reported as an IBCTransferAcknowledgement
+/// or IBCTransferTimeout log emitted on the address of the sender, in the precompile_log events of
+/// the Cosmos transaction relaying it. These logs are returned by the JSON-RPC log queries of the
+/// block, with the Tendermint hash of the Cosmos transaction as their transaction hash.
+interface IICS20 {
+    /// @dev Emitted by the precompile when the sender transfers tokens over IBC.
+    event IBCTransfer(
+        address indexed sender,
+        uint64 indexed sequence,
+        string channel,
+        string receiver,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev Emitted on the sender address when the transfer packet is acknowledged. A transfer that
+    /// failed on the counterparty chain is refunded to the sender.
+    event IBCTransferAcknowledgement(
+        address indexed sender,
+        uint64 indexed sequence,
+        string channel,
+        bool success
+    );
+
+    /// @dev Emitted on the sender address when the transfer packet times out. The transfer is
+    /// refunded to the sender.
+    event IBCTransferTimeout(address indexed sender, uint64 indexed sequence, string channel);
+
+    /// @dev Transfers the given amount of the denomination to the receiver on the counterparty chain
+    /// of the transfer channel, and returns the sequence of the packet sent. The timeout timestamp
+    /// is the unix time in nanoseconds after which the packet times out.
+    function transfer(
+        string calldata channel,
+        string calldata receiver,
+        string calldata denom,
+        uint256 amount,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 sequence);
+}
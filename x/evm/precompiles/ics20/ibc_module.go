@@ -0,0 +1,116 @@
+package ics20
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// EVMKeeper defines the expected EVM keeper interface of the IBC module wrapper
+type EVMKeeper interface {
+	GetContractState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	DeleteContractState(ctx sdk.Context, addr common.Address, key common.Hash)
+	EmitPrecompileLogs(ctx sdk.Context, logs []*ethtypes.Log)
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule wraps the IBC module of the transfer application to report the acknowledgements and
+// timeouts of the packets sent through the precompile as EVM logs of their sender.
+type IBCModule struct {
+	porttypes.IBCModule
+
+	evmKeeper EVMKeeper
+}
+
+// NewIBCModule creates a new IBC module wrapping the given transfer IBC module
+func NewIBCModule(app porttypes.IBCModule, evmKeeper EVMKeeper) IBCModule {
+	return IBCModule{
+		IBCModule: app,
+		evmKeeper: evmKeeper,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It emits an acknowledgement log if
+// the packet was sent through the precompile.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	res, err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err != nil {
+		return nil, err
+	}
+
+	// the acknowledgement has been decoded by the transfer module already
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, err
+	}
+
+	if err := im.emitPacketLog(ctx, packet, AcknowledgementEvent, packet.SourceChannel, ack.Success()); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It emits a timeout log if the packet was
+// sent through the precompile.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	res, err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := im.emitPacketLog(ctx, packet, TimeoutEvent, packet.SourceChannel); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// emitPacketLog emits the log of the given event on the address of the sender of the packet, i.e.
+// the contract or the account that called the precompile, indexed by the sender and the sequence of
+// the packet, and clears the sender recorded by the precompile. The sender can thus filter the
+// callbacks of its transfers by its own address. It is a no-op for the packets that weren't sent
+// through the precompile.
+func (im IBCModule) emitPacketLog(ctx sdk.Context, packet channeltypes.Packet, name string, args ...interface{}) error {
+	key := PacketKey(packet.SourceChannel, packet.Sequence)
+	value := im.evmKeeper.GetContractState(ctx, Address, key)
+	if value == (common.Hash{}) {
+		return nil
+	}
+
+	im.evmKeeper.DeleteContractState(ctx, Address, key)
+
+	event := ABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		return err
+	}
+
+	sender := common.BytesToAddress(value.Bytes())
+	im.evmKeeper.EmitPrecompileLogs(ctx, []*ethtypes.Log{{
+		Address: sender,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(sender.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(packet.Sequence)),
+		},
+		Data: data,
+	}})
+
+	return nil
+}
@@ -0,0 +1,207 @@
+// Package ics20 implements the ICS-20 precompiled contract, which sends fungible token transfers
+// over IBC through the transfer module, so that accounts and contracts can move funds to other
+// chains atomically with their EVM logic. The caller of the precompile acts as the sender. The
+// acknowledgements and timeouts of the transfers are reported back as EVM logs of the sender by
+// the IBC module wrapper of this package. The Solidity interface of the precompile is defined in
+// IICS20.sol.
+package ics20
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const (
+	// TransferMethod defines the ABI method name of the IBC transfer
+	TransferMethod = "transfer"
+
+	// TransferEvent defines the ABI event name emitted on IBC transfers
+	TransferEvent = "IBCTransfer"
+	// AcknowledgementEvent defines the ABI event name emitted on the acknowledgement of a transfer
+	AcknowledgementEvent = "IBCTransferAcknowledgement"
+	// TimeoutEvent defines the ABI event name emitted on the timeout of a transfer
+	TimeoutEvent = "IBCTransferTimeout"
+)
+
+// TransferGas is the gas cost of the transfer method
+const TransferGas uint64 = 100000
+
+var (
+	// Address is the reserved address of the ICS-20 precompiled contract
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000802")
+
+	//go:embed abi.json
+	abiJSON []byte
+	// ABI is the ABI of the ICS-20 precompiled contract
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// TransferKeeper defines the expected IBC transfer keeper interface of the precompile
+type TransferKeeper interface {
+	GetPort(ctx sdk.Context) string
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper interface of the precompile
+type ChannelKeeper interface {
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// Precompile is the ICS-20 precompiled contract
+type Precompile struct {
+	transferKeeper TransferKeeper
+	channelKeeper  ChannelKeeper
+}
+
+// NewPrecompile creates a new ICS-20 precompiled contract
+func NewPrecompile(transferKeeper TransferKeeper, channelKeeper ChannelKeeper) Precompile {
+	return Precompile{
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+	}
+}
+
+// Address implements StatefulPrecompiledContract
+func (p Precompile) Address() common.Address {
+	return Address
+}
+
+// RequiredGas implements StatefulPrecompiledContract. It returns the gas cost of the called method,
+// or zero if the method is unknown, as the call fails anyway.
+func (p Precompile) RequiredGas(_ sdk.Context, input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	switch method.Name {
+	case TransferMethod:
+		return TransferGas
+	default:
+		return 0
+	}
+}
+
+// Run implements StatefulPrecompiledContract
+func (p Precompile) Run(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, errors.New("invalid input length")
+	}
+
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.Value().Sign() > 0 {
+		return nil, fmt.Errorf("method %s is not payable", method.Name)
+	}
+
+	if readOnly {
+		return nil, vm.ErrWriteProtection
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case TransferMethod:
+		return p.transfer(ctx, evm, contract.Caller(), method, args)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+func (p Precompile) transfer(
+	ctx sdk.Context, evm *vm.EVM, sender common.Address, method *abi.Method, args []interface{},
+) ([]byte, error) {
+	channel, _ := args[0].(string)
+	receiver, _ := args[1].(string)
+	denom, _ := args[2].(string)
+	amount, _ := args[3].(*big.Int)
+	timeoutTimestamp, _ := args[4].(uint64)
+
+	port := p.transferKeeper.GetPort(ctx)
+	msg := &transfertypes.MsgTransfer{
+		SourcePort:       port,
+		SourceChannel:    channel,
+		Token:            sdk.Coin{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)},
+		Sender:           sdk.AccAddress(sender.Bytes()).String(),
+		Receiver:         receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// the transfer response doesn't contain the sequence of the packet sent
+	sequence, found := p.channelKeeper.GetNextSequenceSend(ctx, port, channel)
+	if !found {
+		return nil, fmt.Errorf("sequence send not found for port %s and channel %s", port, channel)
+	}
+
+	if _, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	// record the sender of the packet in the storage of the precompile, so that the IBC callbacks
+	// can report back to it
+	evm.StateDB.SetState(Address, PacketKey(channel, sequence), common.BytesToHash(sender.Bytes()))
+
+	event := ABI.Events[TransferEvent]
+	data, err := event.Inputs.NonIndexed().Pack(channel, receiver, denom, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address: Address,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(sender.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(sequence)),
+		},
+		Data:        data,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+
+	return method.Outputs.Pack(sequence)
+}
+
+// PacketKey returns the storage slot of the precompile that records the sender of the transfer
+// packet sent on the given channel with the given sequence.
+func PacketKey(channel string, sequence uint64) common.Hash {
+	return crypto.Keccak256Hash([]byte(channel), sdk.Uint64ToBigEndian(sequence))
+}
@@ -0,0 +1,297 @@
+package ics20_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/ethereum/rpc/backend"
+	"github.com/tharsis/ethermint/ethereum/rpc/namespaces/eth/filters"
+	rpctypes "github.com/tharsis/ethermint/ethereum/rpc/types"
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm/keeper"
+	"github.com/tharsis/ethermint/x/evm/precompiles/ics20"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const (
+	channel  = "channel-0"
+	receiver = "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+	denom    = "aphoton"
+)
+
+// transferKeeper records the transfers sent through the precompile
+type transferKeeper struct {
+	msgs []*transfertypes.MsgTransfer
+	err  error
+}
+
+func (k *transferKeeper) GetPort(_ sdk.Context) string {
+	return transfertypes.PortID
+}
+
+func (k *transferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	if k.err != nil {
+		return nil, k.err
+	}
+	k.msgs = append(k.msgs, msg)
+	return &transfertypes.MsgTransferResponse{}, nil
+}
+
+type channelKeeper struct {
+	sequence uint64
+}
+
+func (k channelKeeper) GetNextSequenceSend(_ sdk.Context, portID, channelID string) (uint64, bool) {
+	return k.sequence, portID == transfertypes.PortID && channelID == channel
+}
+
+// transferModule is a transfer IBC module whose callbacks always succeed
+type transferModule struct {
+	porttypes.IBCModule
+}
+
+func (transferModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+func (transferModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	app            *app.EthermintApp
+	evmKeeper      *keeper.Keeper
+	address        common.Address
+	transferKeeper *transferKeeper
+	ibcModule      ics20.IBCModule
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	// the precompile registered by the app is replaced by one using the test keepers
+	suite.transferKeeper = &transferKeeper{}
+	suite.evmKeeper = keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(evmtypes.StoreKey), suite.app.GetTKey(evmtypes.TransientKey),
+		suite.app.GetSubspace(evmtypes.ModuleName), suite.app.AccountKeeper, suite.app.BankKeeper,
+		suite.app.StakingKeeper, suite.app.FeeMarketKeeper, suite.app.DistrKeeper, "", false,
+	)
+	suite.evmKeeper.RegisterPrecompiles(ics20.NewPrecompile(suite.transferKeeper, channelKeeper{sequence: 7}))
+	suite.evmKeeper.WithContext(suite.ctx)
+	suite.evmKeeper.WithChainID(suite.ctx)
+
+	params := suite.evmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{ics20.Address.Hex()}
+	suite.evmKeeper.SetParams(suite.ctx, params)
+
+	suite.ibcModule = ics20.NewIBCModule(transferModule{}, suite.evmKeeper)
+	suite.address = tests.GenerateAddress()
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+// call applies a message from the given sender and commits its state changes.
+func (suite *PrecompileTestSuite) call(from, to common.Address, data []byte) *evmtypes.MsgEthereumTxResponse {
+	k := suite.evmKeeper
+	k.WithContext(suite.ctx)
+
+	params := k.GetParams(suite.ctx)
+	cfg := params.ChainConfig.EthereumConfig(k.ChainID())
+
+	msg := ethtypes.NewMessage(from, &to, k.GetNonce(from), big.NewInt(0), 1000000, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, false)
+	evm := k.NewEVM(msg, cfg, params, common.Address{}, nil)
+
+	res, err := k.ApplyMessage(evm, msg, cfg, false)
+	suite.Require().NoError(err)
+	if !res.Failed() {
+		k.CommitCachedContexts()
+	}
+	res.Logs = evmtypes.NewLogsFromEth(k.GetTxLogsTransient(common.Hash{}))
+	return res
+}
+
+func (suite *PrecompileTestSuite) transfer(amount int64) *evmtypes.MsgEthereumTxResponse {
+	input, err := ics20.ABI.Pack(ics20.TransferMethod, channel, receiver, denom, big.NewInt(amount), uint64(1e18))
+	suite.Require().NoError(err)
+	return suite.call(suite.address, ics20.Address, input)
+}
+
+func (suite *PrecompileTestSuite) packetSender(sequence uint64) common.Address {
+	value := suite.evmKeeper.GetContractState(suite.ctx, ics20.Address, ics20.PacketKey(channel, sequence))
+	return common.BytesToAddress(value.Bytes())
+}
+
+// precompileLogs returns the logs of the precompile log events emitted on the context.
+func (suite *PrecompileTestSuite) precompileLogs() []*ethtypes.Log {
+	logs := []*evmtypes.Log{}
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != evmtypes.EventTypePrecompileLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			var log evmtypes.Log
+			suite.app.AppCodec().MustUnmarshal(attr.Value, &log)
+			logs = append(logs, &log)
+		}
+	}
+	return evmtypes.LogsToEthereum(logs)
+}
+
+// logsBackend is a JSON-RPC backend serving the logs of the events emitted on the context of the
+// test suite as the logs of its block.
+type logsBackend struct {
+	filters.Backend
+
+	suite *PrecompileTestSuite
+}
+
+func (b logsBackend) HeaderByNumber(_ rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{
+		Number: big.NewInt(b.suite.ctx.BlockHeight()),
+		Bloom:  ethtypes.BytesToBloom(b.suite.evmKeeper.GetBlockBloomTransient().Bytes()),
+	}, nil
+}
+
+func (b logsBackend) GetLogsByNumber(_ rpctypes.BlockNumber) ([][]*ethtypes.Log, error) {
+	events := b.suite.ctx.EventManager().ABCIEvents()
+	return [][]*ethtypes.Log{backend.TxLogsFromEvents(b.suite.app.AppCodec(), events)}, nil
+}
+
+func (b logsBackend) BloomStatus() (uint64, uint64) {
+	return 4096, 0
+}
+
+func (suite *PrecompileTestSuite) TestTransfer() {
+	res := suite.transfer(100)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(7)).Bytes(), res.Ret)
+
+	suite.Require().Len(suite.transferKeeper.msgs, 1)
+	msg := suite.transferKeeper.msgs[0]
+	suite.Require().Equal(transfertypes.PortID, msg.SourcePort)
+	suite.Require().Equal(channel, msg.SourceChannel)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), msg.Token)
+	suite.Require().Equal(sdk.AccAddress(suite.address.Bytes()).String(), msg.Sender)
+	suite.Require().Equal(receiver, msg.Receiver)
+	suite.Require().Equal(uint64(1e18), msg.TimeoutTimestamp)
+
+	suite.Require().Equal(suite.address, suite.packetSender(7))
+
+	suite.Require().Len(res.Logs, 1)
+	log := res.Logs[0]
+	suite.Require().Equal(ics20.Address.Hex(), log.Address)
+	suite.Require().Equal([]string{
+		ics20.ABI.Events[ics20.TransferEvent].ID.Hex(),
+		common.BytesToHash(suite.address.Bytes()).Hex(),
+		common.BigToHash(big.NewInt(7)).Hex(),
+	}, log.Topics)
+}
+
+func (suite *PrecompileTestSuite) TestTransferFailure() {
+	// invalid amount
+	res := suite.transfer(0)
+	suite.Require().True(res.Failed())
+	suite.Require().Empty(suite.transferKeeper.msgs)
+
+	// the transfer fails, the sender is not recorded
+	suite.transferKeeper.err = errors.New("insufficient funds")
+	res = suite.transfer(100)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(common.Address{}, suite.packetSender(7))
+}
+
+func (suite *PrecompileTestSuite) TestOnAcknowledgementPacket() {
+	testCases := []struct {
+		name    string
+		ack     channeltypes.Acknowledgement
+		success bool
+	}{
+		{"success", channeltypes.NewResultAcknowledgement([]byte{1}), true},
+		{"error", channeltypes.NewErrorAcknowledgement("failed"), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().False(suite.transfer(100).Failed())
+
+			packet := channeltypes.Packet{Sequence: 7, SourcePort: transfertypes.PortID, SourceChannel: channel}
+			_, err := suite.ibcModule.OnAcknowledgementPacket(suite.ctx, packet, tc.ack.Acknowledgement(), nil)
+			suite.Require().NoError(err)
+
+			logs := suite.precompileLogs()
+			suite.Require().Len(logs, 1)
+			event := ics20.ABI.Events[ics20.AcknowledgementEvent]
+			suite.Require().Equal(suite.address, logs[0].Address)
+			suite.Require().Equal([]common.Hash{
+				event.ID,
+				common.BytesToHash(suite.address.Bytes()),
+				common.BigToHash(big.NewInt(7)),
+			}, logs[0].Topics)
+
+			args, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]interface{}{channel, tc.success}, args)
+
+			// the log is returned by the log queries of the block filtering on the sender address
+			filter := filters.NewRangeFilter(log.NewNopLogger(), logsBackend{suite: suite}, 1, 1, []common.Address{suite.address}, nil)
+			queried, err := filter.Logs(context.Background())
+			suite.Require().NoError(err)
+			suite.Require().Equal(logs, queried)
+
+			// the sender is cleared
+			suite.Require().Equal(common.Address{}, suite.packetSender(7))
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestOnTimeoutPacket() {
+	suite.Require().False(suite.transfer(100).Failed())
+
+	// packets that weren't sent through the precompile are ignored
+	packet := channeltypes.Packet{Sequence: 8, SourcePort: transfertypes.PortID, SourceChannel: channel}
+	_, err := suite.ibcModule.OnTimeoutPacket(suite.ctx, packet, nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.precompileLogs())
+
+	packet.Sequence = 7
+	_, err = suite.ibcModule.OnTimeoutPacket(suite.ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	logs := suite.precompileLogs()
+	suite.Require().Len(logs, 1)
+	event := ics20.ABI.Events[ics20.TimeoutEvent]
+	suite.Require().Equal(suite.address, logs[0].Address)
+	suite.Require().Equal([]common.Hash{
+		event.ID,
+		common.BytesToHash(suite.address.Bytes()),
+		common.BigToHash(big.NewInt(7)),
+	}, logs[0].Topics)
+	suite.Require().Equal(uint64(1), logs[0].BlockNumber)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity >=0.8.0;
+
+/// @dev The staking precompiled contract address.
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
+
+/// @title Staking precompiled contract
+/// @notice Interface of the precompiled contract wrapping the staking and distribution modules. The
+/// caller of each method acts as the delegator. Validators are identified by their bech32 operator
+/// address and amounts are expressed in the bond denomination.
+interface IStaking {
+    /// @dev Emitted when the delegator delegates to a validator.
+    event Delegate(address indexed delegator, string validator, uint256 amount);
+
+    /// @dev Emitted when the delegator undelegates from a validator.
+    event Undelegate(address indexed delegator, string validator, uint256 amount, int64 completionTime);
+
+    /// @dev Emitted when the delegator redelegates from a validator to another.
+    event Redelegate(
+        address indexed delegator,
+        string srcValidator,
+        string dstValidator,
+        uint256 amount,
+        int64 completionTime
+    );
+
+    /// @dev Emitted when the delegator withdraws the rewards of a delegation.
+    event WithdrawRewards(address indexed delegator, string validator, uint256 amount);
+
+    /// @dev Delegates the given amount to the validator.
+    function delegate(string calldata validator, uint256 amount) external returns (bool);
+
+    /// @dev Undelegates the given amount from the validator, and returns the unix time at which
+    /// the unbonding completes.
+    function undelegate(string calldata validator, uint256 amount) external returns (int64 completionTime);
+
+    /// @dev Redelegates the given amount from a validator to another, and returns the unix time at
+    /// which the redelegation completes, or zero if it completes immediately.
+    function redelegate(
+        string calldata srcValidator,
+        string calldata dstValidator,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Withdraws the rewards of the delegation to the validator, and returns the amount
+    /// withdrawn.
+    function withdrawRewards(string calldata validator) external returns (uint256 amount);
+
+    /// @dev Returns the shares, with 18 decimals, and the balance of the delegation.
+    function delegation(address delegator, string calldata validator)
+        external
+        view
+        returns (uint256 shares, uint256 balance);
+
+    /// @dev Returns the pending rewards of the delegation.
+    function rewards(address delegator, string calldata validator) external view returns (uint256 amount);
+}
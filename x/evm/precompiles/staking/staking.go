@@ -0,0 +1,382 @@
+// Package staking implements the staking precompiled contract, which wraps the message servers of
+// the staking and distribution modules so that accounts and contracts can delegate, undelegate,
+// redelegate and withdraw their rewards from the EVM. The caller of the precompile acts as the
+// delegator. The Solidity interface of the precompile is defined in IStaking.sol.
+package staking
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const (
+	// DelegateMethod defines the ABI method name of the delegation
+	DelegateMethod = "delegate"
+	// UndelegateMethod defines the ABI method name of the undelegation
+	UndelegateMethod = "undelegate"
+	// RedelegateMethod defines the ABI method name of the redelegation
+	RedelegateMethod = "redelegate"
+	// WithdrawRewardsMethod defines the ABI method name of the rewards withdrawal
+	WithdrawRewardsMethod = "withdrawRewards"
+	// DelegationMethod defines the ABI method name of the delegation query
+	DelegationMethod = "delegation"
+	// RewardsMethod defines the ABI method name of the rewards query
+	RewardsMethod = "rewards"
+
+	// DelegateEvent defines the ABI event name emitted on delegations
+	DelegateEvent = "Delegate"
+	// UndelegateEvent defines the ABI event name emitted on undelegations
+	UndelegateEvent = "Undelegate"
+	// RedelegateEvent defines the ABI event name emitted on redelegations
+	RedelegateEvent = "Redelegate"
+	// WithdrawRewardsEvent defines the ABI event name emitted on rewards withdrawals
+	WithdrawRewardsEvent = "WithdrawRewards"
+)
+
+var (
+	// Address is the reserved address of the staking precompiled contract
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000801")
+
+	//go:embed abi.json
+	abiJSON []byte
+	// ABI is the ABI of the staking precompiled contract
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EVMKeeper defines the expected EVM keeper interface of the precompile
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// Precompile is the staking precompiled contract. The gas costs of its methods are defined by the
+// StakingPrecompileGas parameter of the EVM module.
+type Precompile struct {
+	stakingKeeper    stakingkeeper.Keeper
+	evmKeeper        EVMKeeper
+	stakingMsgServer stakingtypes.MsgServer
+	distrMsgServer   distrtypes.MsgServer
+	distrQuerier     distrtypes.QueryServer
+}
+
+// NewPrecompile creates a new staking precompiled contract. The staking keeper must have its hooks
+// set, as the precompile delegates through the staking message server.
+func NewPrecompile(stakingKeeper stakingkeeper.Keeper, distrKeeper distrkeeper.Keeper, evmKeeper EVMKeeper) Precompile {
+	return Precompile{
+		stakingKeeper:    stakingKeeper,
+		evmKeeper:        evmKeeper,
+		stakingMsgServer: stakingkeeper.NewMsgServerImpl(stakingKeeper),
+		distrMsgServer:   distrkeeper.NewMsgServerImpl(distrKeeper),
+		distrQuerier:     distrKeeper,
+	}
+}
+
+// Address implements StatefulPrecompiledContract
+func (p Precompile) Address() common.Address {
+	return Address
+}
+
+// RequiredGas implements StatefulPrecompiledContract. It returns the gas cost of the called method,
+// or zero if the method is unknown, as the call fails anyway.
+func (p Precompile) RequiredGas(ctx sdk.Context, input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	gas := p.evmKeeper.GetParams(ctx).StakingPrecompileGas
+	switch method.Name {
+	case DelegateMethod:
+		return gas.Delegate
+	case UndelegateMethod:
+		return gas.Undelegate
+	case RedelegateMethod:
+		return gas.Redelegate
+	case WithdrawRewardsMethod:
+		return gas.WithdrawRewards
+	case DelegationMethod:
+		return gas.Delegation
+	case RewardsMethod:
+		return gas.Rewards
+	default:
+		return 0
+	}
+}
+
+// Run implements StatefulPrecompiledContract
+func (p Precompile) Run(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, errors.New("invalid input length")
+	}
+
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.Value().Sign() > 0 {
+		return nil, fmt.Errorf("method %s is not payable", method.Name)
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case DelegationMethod:
+		return p.delegation(ctx, method, args)
+	case RewardsMethod:
+		return p.rewards(ctx, method, args)
+	}
+
+	if readOnly {
+		return nil, vm.ErrWriteProtection
+	}
+
+	delegator := contract.Caller()
+
+	switch method.Name {
+	case DelegateMethod:
+		return p.delegate(ctx, evm, delegator, method, args)
+	case UndelegateMethod:
+		return p.undelegate(ctx, evm, delegator, method, args)
+	case RedelegateMethod:
+		return p.redelegate(ctx, evm, delegator, method, args)
+	case WithdrawRewardsMethod:
+		return p.withdrawRewards(ctx, evm, delegator, method, args)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+func (p Precompile) delegate(
+	ctx sdk.Context, evm *vm.EVM, delegator common.Address, method *abi.Method, args []interface{},
+) ([]byte, error) {
+	validator, _ := args[0].(string)
+	amount, _ := args[1].(*big.Int)
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator,
+		Amount:           p.bondCoin(ctx, amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.stakingMsgServer.Delegate(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitEvent(evm, DelegateEvent, delegator, validator, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) undelegate(
+	ctx sdk.Context, evm *vm.EVM, delegator common.Address, method *abi.Method, args []interface{},
+) ([]byte, error) {
+	validator, _ := args[0].(string)
+	amount, _ := args[1].(*big.Int)
+
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator,
+		Amount:           p.bondCoin(ctx, amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.stakingMsgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.Unix()
+	if err := p.emitEvent(evm, UndelegateEvent, delegator, validator, amount, completionTime); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+func (p Precompile) redelegate(
+	ctx sdk.Context, evm *vm.EVM, delegator common.Address, method *abi.Method, args []interface{},
+) ([]byte, error) {
+	srcValidator, _ := args[0].(string)
+	dstValidator, _ := args[1].(string)
+	amount, _ := args[2].(*big.Int)
+
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorSrcAddress: srcValidator,
+		ValidatorDstAddress: dstValidator,
+		Amount:              p.bondCoin(ctx, amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.stakingMsgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the redelegations from unbonded validators complete immediately, with a zero completion time
+	var completionTime int64
+	if !res.CompletionTime.IsZero() {
+		completionTime = res.CompletionTime.Unix()
+	}
+	if err := p.emitEvent(evm, RedelegateEvent, delegator, srcValidator, dstValidator, amount, completionTime); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+func (p Precompile) withdrawRewards(
+	ctx sdk.Context, evm *vm.EVM, delegator common.Address, method *abi.Method, args []interface{},
+) ([]byte, error) {
+	validator, _ := args[0].(string)
+
+	msg := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// the rewards withdrawn are the truncated pending rewards
+	amount, err := p.pendingRewards(ctx, delegator, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.distrMsgServer.WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitEvent(evm, WithdrawRewardsEvent, delegator, validator, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(amount)
+}
+
+// delegation returns the shares and the balance of a delegation, or zero values if the delegation
+// doesn't exist.
+func (p Precompile) delegation(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	delegator, _ := args[0].(common.Address)
+	validator, _ := args[1].(string)
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, found := p.stakingKeeper.GetDelegation(ctx, delegator.Bytes(), valAddr)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), big.NewInt(0))
+	}
+
+	val, found := p.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, fmt.Errorf("validator %s not found", validator)
+	}
+
+	balance := val.TokensFromShares(delegation.Shares).TruncateInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance.BigInt())
+}
+
+func (p Precompile) rewards(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	delegator, _ := args[0].(common.Address)
+	validator, _ := args[1].(string)
+
+	amount, err := p.pendingRewards(ctx, delegator, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(amount)
+}
+
+// pendingRewards returns the truncated pending rewards of a delegation in the bond denomination,
+// or zero if the delegation doesn't exist.
+func (p Precompile) pendingRewards(ctx sdk.Context, delegator common.Address, validator string) (*big.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := p.stakingKeeper.GetDelegation(ctx, delegator.Bytes(), valAddr); !found {
+		return big.NewInt(0), nil
+	}
+
+	// the query increments the validator period, which must not be persisted by the view method
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := p.distrQuerier.DelegationRewards(sdk.WrapSDKContext(cacheCtx), &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Rewards.AmountOf(p.stakingKeeper.BondDenom(ctx)).TruncateInt().BigInt(), nil
+}
+
+// bondCoin returns the coin of the given amount in the bond denomination.
+func (p Precompile) bondCoin(ctx sdk.Context, amount *big.Int) sdk.Coin {
+	return sdk.Coin{Denom: p.stakingKeeper.BondDenom(ctx), Amount: sdk.NewIntFromBigInt(amount)}
+}
+
+// emitEvent adds the log of the given event, indexed by the delegator, to the EVM state.
+func (p Precompile) emitEvent(evm *vm.EVM, name string, delegator common.Address, args ...interface{}) error {
+	event := ABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		return err
+	}
+
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address:     Address,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(delegator.Bytes())},
+		Data:        data,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm/precompiles/staking"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *app.EthermintApp
+	address    common.Address
+	validators []sdk.ValAddress
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{staking.Address.Hex()}
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	suite.address = tests.GenerateAddress()
+	suite.mint(suite.address.Bytes(), 1000)
+
+	suite.validators = []sdk.ValAddress{suite.createValidator(), suite.createValidator()}
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) bondDenom() string {
+	return suite.app.StakingKeeper.BondDenom(suite.ctx)
+}
+
+func (suite *PrecompileTestSuite) mint(address sdk.AccAddress, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.bondDenom(), amount))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, evmtypes.ModuleName, address, coins))
+}
+
+func (suite *PrecompileTestSuite) createValidator() sdk.ValAddress {
+	operator := tests.GenerateAddress()
+	suite.mint(operator.Bytes(), 1000)
+
+	valAddr := sdk.ValAddress(operator.Bytes())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewInt64Coin(suite.bondDenom(), 1000),
+		stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(),
+	)
+	suite.Require().NoError(err)
+
+	_, err = stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	return valAddr
+}
+
+// call applies a message from the account of the suite and commits its state changes.
+func (suite *PrecompileTestSuite) call(method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, []interface{}) {
+	input, err := staking.ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	k := suite.app.EvmKeeper
+	k.WithContext(suite.ctx)
+
+	params := k.GetParams(suite.ctx)
+	cfg := params.ChainConfig.EthereumConfig(k.ChainID())
+
+	msg := ethtypes.NewMessage(suite.address, &staking.Address, k.GetNonce(suite.address), big.NewInt(0), 1000000, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false)
+	evm := k.NewEVM(msg, cfg, params, common.Address{}, nil)
+
+	res, err := k.ApplyMessage(evm, msg, cfg, false)
+	suite.Require().NoError(err)
+	if res.Failed() {
+		return res, nil
+	}
+
+	k.CommitCachedContexts()
+	res.Logs = evmtypes.NewLogsFromEth(k.GetTxLogsTransient(common.Hash{}))
+
+	out, err := staking.ABI.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return res, out
+}
+
+func (suite *PrecompileTestSuite) delegation(validator sdk.ValAddress) (*big.Int, *big.Int) {
+	res, out := suite.call(staking.DelegationMethod, suite.address, validator.String())
+	suite.Require().False(res.Failed(), res.VmError)
+	return out[0].(*big.Int), out[1].(*big.Int)
+}
+
+// requireEvent checks the last log of the response, as the logs of the calls accumulate in the
+// transient store of the suite context.
+func (suite *PrecompileTestSuite) requireEvent(res *evmtypes.MsgEthereumTxResponse, name string) {
+	suite.Require().NotEmpty(res.Logs)
+	log := res.Logs[len(res.Logs)-1]
+	suite.Require().Equal(staking.Address.Hex(), log.Address)
+	suite.Require().Equal(staking.ABI.Events[name].ID.Hex(), log.Topics[0])
+	suite.Require().Equal(common.BytesToHash(suite.address.Bytes()).Hex(), log.Topics[1])
+}
+
+func (suite *PrecompileTestSuite) TestDelegate() {
+	_, balance := suite.delegation(suite.validators[0])
+	suite.Require().Zero(balance.Sign())
+
+	res, _ := suite.call(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(400))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.requireEvent(res, staking.DelegateEvent)
+
+	shares, balance := suite.delegation(suite.validators[0])
+	suite.Require().Equal(sdk.NewDec(400).BigInt(), shares)
+	suite.Require().Equal(big.NewInt(400), balance)
+	suite.Require().Equal(int64(600), suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), suite.bondDenom()).Amount.Int64())
+
+	// insufficient funds
+	res, _ = suite.call(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(601))
+	suite.Require().True(res.Failed())
+
+	// zero amount
+	res, _ = suite.call(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(0))
+	suite.Require().True(res.Failed())
+
+	// invalid validator address
+	res, _ = suite.call(staking.DelegateMethod, "validator", big.NewInt(1))
+	suite.Require().True(res.Failed())
+
+	_, balance = suite.delegation(suite.validators[0])
+	suite.Require().Equal(big.NewInt(400), balance)
+}
+
+func (suite *PrecompileTestSuite) TestUndelegate() {
+	res, _ := suite.call(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(400))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res, out := suite.call(staking.UndelegateMethod, suite.validators[0].String(), big.NewInt(100))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.requireEvent(res, staking.UndelegateEvent)
+
+	unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(unbondingTime).Unix(), out[0].(int64))
+
+	_, balance := suite.delegation(suite.validators[0])
+	suite.Require().Equal(big.NewInt(300), balance)
+
+	// more than the delegation
+	res, _ = suite.call(staking.UndelegateMethod, suite.validators[0].String(), big.NewInt(301))
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestRedelegate() {
+	res, _ := suite.call(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(400))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res, out := suite.call(staking.RedelegateMethod, suite.validators[0].String(), suite.validators[1].String(), big.NewInt(100))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.requireEvent(res, staking.RedelegateEvent)
+	// the redelegation from an unbonded validator completes immediately
+	suite.Require().Zero(out[0].(int64))
+
+	_, balance := suite.delegation(suite.validators[0])
+	suite.Require().Equal(big.NewInt(300), balance)
+	_, balance = suite.delegation(suite.validators[1])
+	suite.Require().Equal(big.NewInt(100), balance)
+
+	// redelegation to the same validator
+	res, _ = suite.call(staking.RedelegateMethod, suite.validators[0].String(), suite.validators[0].String(), big.NewInt(100))
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestWithdrawRewards() {
+	res, out := suite.call(staking.RewardsMethod, suite.address, suite.validators[0].String())
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Zero(out[0].(*big.Int).Sign())
+
+	res, _ = suite.call(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(1000))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// no rewards are accrued on the block of the delegation
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// allocate rewards to the validator, shared with the self delegation of the operator
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(suite.bondDenom(), 500))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, rewards))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, evmtypes.ModuleName, distrtypes.ModuleName, rewards))
+
+	validator := suite.app.StakingKeeper.Validator(suite.ctx, suite.validators[0])
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+	res, out = suite.call(staking.RewardsMethod, suite.address, suite.validators[0].String())
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(big.NewInt(250), out[0].(*big.Int))
+
+	res, out = suite.call(staking.WithdrawRewardsMethod, suite.validators[0].String())
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.requireEvent(res, staking.WithdrawRewardsEvent)
+	suite.Require().Equal(big.NewInt(250), out[0].(*big.Int))
+	suite.Require().Equal(int64(250), suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), suite.bondDenom()).Amount.Int64())
+
+	res, out = suite.call(staking.RewardsMethod, suite.address, suite.validators[0].String())
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Zero(out[0].(*big.Int).Sign())
+}
+
+func (suite *PrecompileTestSuite) TestRequiredGas() {
+	precompile := staking.NewPrecompile(suite.app.StakingKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper)
+
+	input, err := staking.ABI.Pack(staking.DelegateMethod, suite.validators[0].String(), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(evmtypes.DefaultStakingPrecompileGas().Delegate, precompile.RequiredGas(suite.ctx, input))
+
+	res, _ := suite.call(staking.DelegationMethod, suite.address, suite.validators[0].String())
+	suite.Require().False(res.Failed(), res.VmError)
+	gasUsed := res.GasUsed
+
+	// the gas costs are governed by the EVM module params
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.StakingPrecompileGas.Delegate = 100000
+	params.StakingPrecompileGas.Delegation = 8000
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(uint64(100000), precompile.RequiredGas(suite.ctx, input))
+
+	res, _ = suite.call(staking.DelegationMethod, suite.address, suite.validators[0].String())
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(gasUsed+3000, res.GasUsed)
+}
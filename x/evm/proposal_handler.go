@@ -0,0 +1,48 @@
+package evm
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/ethermint/x/evm/keeper"
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// NewProposalHandler returns the governance proposal handler of the evm module
+func NewProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ScheduleForkProposal:
+			return handleScheduleForkProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleScheduleForkProposal(ctx sdk.Context, k *keeper.Keeper, p *types.ScheduleForkProposal) error {
+	if err := k.ScheduleFork(ctx, p.Fork, p.Height, p.ExtraEIPs); err != nil {
+		return err
+	}
+
+	eips := make([]string, len(p.ExtraEIPs))
+	for i, eip := range p.ExtraEIPs {
+		eips[i] = strconv.FormatInt(eip, 10)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleFork,
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(p.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyForks, p.Fork),
+			sdk.NewAttribute(types.AttributeKeyExtraEIPs, strings.Join(eips, ",")),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func init() {
+	register("callTracer", newCallTracer)
+}
+
+// callFrame is a call made during the transaction execution, along with the calls it made.
+type callFrame struct {
+	Type    string      `json:"type"`
+	From    string      `json:"from"`
+	To      string      `json:"to,omitempty"`
+	Value   string      `json:"value,omitempty"`
+	Gas     string      `json:"gas,omitempty"`
+	GasUsed string      `json:"gasUsed,omitempty"`
+	Input   string      `json:"input"`
+	Output  string      `json:"output,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Calls   []callFrame `json:"calls,omitempty"`
+
+	// gas available and cost of the opcode that made the call
+	gasIn   uint64
+	gasCost uint64
+	// gas available within the call, only known if the callee has code
+	gas    uint64
+	hasGas bool
+	// location of the call output in the caller memory
+	outOff uint256.Int
+	outLen uint256.Int
+}
+
+// callTracer is a native Go tracer that reports all the calls made by a transaction as a tree
+// of call frames. The output format matches the one from the go-ethereum callTracer.
+// NOTE: as the EVM doesn't notify the tracer when entering and exiting calls, the frames are
+// tracked from the executed opcodes, the same way as the go-ethereum JavaScript callTracer does.
+// For the canonical code refer to:
+// https://github.com/ethereum/go-ethereum/blob/v1.10.3/eth/tracers/internal/tracers/call_tracer.js
+type callTracer struct {
+	precompiles []common.Address
+	// callstack is the current recursive call stack of the EVM execution. The first
+	// frame is the transaction one.
+	callstack []callFrame
+	// descended tracks whether we've just descended from an outer call into an inner one.
+	descended bool
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newCallTracer returns a native go tracer which tracks the call frames of a transaction.
+func newCallTracer(precompiles []common.Address) Tracer {
+	return &callTracer{precompiles: precompiles, callstack: make([]callFrame, 1)}
+}
+
+// CaptureStart implements the vm.Tracer interface to initialize the tracing operation.
+func (t *callTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.callstack[0] = callFrame{
+		Type:  "CALL",
+		From:  addrToHex(from),
+		To:    addrToHex(to),
+		Input: bytesToHex(input),
+		Gas:   uintToHex(gas),
+		Value: bigToHex(value),
+	}
+	if create {
+		t.callstack[0].Type = "CREATE"
+	}
+}
+
+// CaptureState implements the vm.Tracer interface to trace a single step of VM execution.
+func (t *callTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+
+	// Capture any errors immediately
+	if err != nil {
+		t.fault(err)
+		return
+	}
+
+	stack := scope.Stack
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		// If a new contract is being created, add to the call stack
+		t.callstack = append(t.callstack, callFrame{
+			Type:    op.String(),
+			From:    addrToHex(scope.Contract.Address()),
+			Input:   bytesToHex(memorySlice(scope.Memory, stack.Back(1), stack.Back(2))),
+			Value:   bigToHex(stack.Back(0).ToBig()),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+		return
+
+	case vm.SELFDESTRUCT:
+		// If a contract is being self destructed, gather that as a subcall too
+		contract := scope.Contract.Address()
+		t.appendCall(callFrame{
+			Type:  op.String(),
+			From:  addrToHex(contract),
+			To:    addrToHex(common.Address(stack.Back(0).Bytes20())),
+			Input: bytesToHex(nil),
+			Value: bigToHex(env.StateDB.GetBalance(contract)),
+		})
+		return
+
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		// Skip any pre-compile invocations, those are just fancy opcodes
+		to := common.Address(stack.Back(1).Bytes20())
+		if isPrecompiled(t.precompiles, to) {
+			return
+		}
+
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+
+		call := callFrame{
+			Type:    op.String(),
+			From:    addrToHex(scope.Contract.Address()),
+			To:      addrToHex(to),
+			Input:   bytesToHex(memorySlice(scope.Memory, stack.Back(2+off), stack.Back(3+off))),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  *stack.Back(4 + off),
+			outLen:  *stack.Back(5 + off),
+		}
+		if off == 1 {
+			call.Value = bigToHex(stack.Back(2).ToBig())
+		}
+		t.callstack = append(t.callstack, call)
+		t.descended = true
+		return
+	}
+
+	// If we've just descended into an inner call, retrieve it's true allowance. We
+	// need to extract if from within the call as there may be funky gas dynamics
+	// with regard to requested and actually given gas (2300 stipend, 63/64 rule).
+	if t.descended {
+		if depth >= len(t.callstack) {
+			t.callstack[len(t.callstack)-1].gas = gas
+			t.callstack[len(t.callstack)-1].hasGas = true
+		}
+		// NOTE: otherwise the call was made to a plain account, for which the
+		// amount of gas available isn't known.
+		t.descended = false
+	}
+
+	// If an existing call is returning, pop off the call stack
+	if op == vm.REVERT {
+		t.callstack[len(t.callstack)-1].Error = vm.ErrExecutionReverted.Error()
+		return
+	}
+
+	if depth != len(t.callstack)-1 {
+		return
+	}
+
+	// Pop off the last call and get the execution results
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+
+	ret := stack.Back(0)
+	if call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String() {
+		// If the call was a CREATE, retrieve the contract address and output code
+		call.GasUsed = uintToHex(call.gasIn - call.gasCost - gas)
+		if !ret.IsZero() {
+			addr := common.Address(ret.Bytes20())
+			call.To = addrToHex(addr)
+			call.Output = bytesToHex(env.StateDB.GetCode(addr))
+		} else if call.Error == "" {
+			call.Error = "internal failure"
+		}
+	} else {
+		// If the call was a contract call, retrieve the gas usage and output
+		if call.hasGas {
+			call.GasUsed = uintToHex(call.gasIn - call.gasCost + call.gas - gas)
+		}
+		if !ret.IsZero() {
+			call.Output = bytesToHex(memorySlice(scope.Memory, &call.outOff, &call.outLen))
+		} else if call.Error == "" {
+			call.Error = "internal failure"
+		}
+	}
+	if call.hasGas {
+		call.Gas = uintToHex(call.gas)
+	}
+
+	// Inject the call into the previous one
+	t.appendCall(call)
+}
+
+// CaptureFault implements the vm.Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *callTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	t.fault(err)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.callstack[0].GasUsed = uintToHex(gasUsed)
+	t.callstack[0].Output = bytesToHex(output)
+	if err != nil && t.callstack[0].Error == "" {
+		t.callstack[0].Error = err.Error()
+	}
+	if t.callstack[0].Error != "" && (t.callstack[0].Error != vm.ErrExecutionReverted.Error() || len(output) == 0) {
+		t.callstack[0].Output = ""
+	}
+}
+
+// GetResult returns the json-encoded nested list of call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *callTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// fault handles an execution error of the current call.
+func (t *callTracer) fault(err error) {
+	// If the topmost call already reverted, don't handle the additional fault again
+	if t.callstack[len(t.callstack)-1].Error != "" {
+		return
+	}
+
+	// Pop off the just failed call
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	call.Error = err.Error()
+
+	// Consume all available gas
+	if call.hasGas {
+		call.Gas = uintToHex(call.gas)
+		call.GasUsed = call.Gas
+	}
+
+	// Flatten the failed call into its parent
+	if len(t.callstack) > 0 {
+		t.appendCall(call)
+		return
+	}
+
+	// Last call failed too, leave it in the stack
+	t.callstack = append(t.callstack, call)
+}
+
+// appendCall adds the given call to the calls of the current frame.
+func (t *callTracer) appendCall(call callFrame) {
+	parent := &t.callstack[len(t.callstack)-1]
+	parent.Calls = append(parent.Calls, call)
+}
+
+func addrToHex(a common.Address) string {
+	return strings.ToLower(a.Hex())
+}
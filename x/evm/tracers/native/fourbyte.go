@@ -0,0 +1,123 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func init() {
+	register("4byteTracer", newFourByteTracer)
+}
+
+// fourByteTracer searches for 4byte-identifiers, and collects them for post-processing.
+// It collects the methods identifiers along with the size of the supplied data, so
+// a reversed signature can be matched against the size of the data. The output format
+// matches the one from the go-ethereum 4byteTracer.
+//
+// Example:
+//
+//	> debug.traceTransaction( "0x214e597e35da083692f5386141e69f47e973b2c56e7a8073b1ea08fd7571e9de", {tracer: "4byteTracer"})
+//	{
+//	  0x27dc297e-128: 1,
+//	  0x38cc4831-0: 2,
+//	  0x524f3889-96: 1,
+//	  0xadf59f99-288: 1,
+//	  0xc281d19e-0: 1
+//	}
+type fourByteTracer struct {
+	ids         map[string]int   // ids aggregates the 4byte ids found
+	precompiles []common.Address // Active precompiles of the traced block, skipped
+	interrupt   uint32           // Atomic flag to signal execution interruption
+	reason      error            // Textual reason for the interruption
+}
+
+// newFourByteTracer returns a native go tracer which collects
+// 4 byte-identifiers of a tx, and implements vm.Tracer.
+func newFourByteTracer(precompiles []common.Address) Tracer {
+	return &fourByteTracer{ids: make(map[string]int), precompiles: precompiles}
+}
+
+// store saves the given identifier and datasize.
+func (t *fourByteTracer) store(id []byte, size int) {
+	key := bytesToHex(id) + "-" + strconv.Itoa(size)
+	t.ids[key]++
+}
+
+// CaptureStart implements the vm.Tracer interface to initialize the tracing operation.
+func (t *fourByteTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	// Save the outer calldata also, including the init code of a contract creation as
+	// go-ethereum does
+	if len(input) >= 4 {
+		t.store(input[0:4], len(input)-4)
+	}
+}
+
+// CaptureState implements the vm.Tracer interface to trace a single step of VM execution.
+func (t *fourByteTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	// stack position of the call input offset
+	var inOff int
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		inOff = 3
+	case vm.DELEGATECALL, vm.STATICCALL:
+		inOff = 2
+	default:
+		return
+	}
+
+	stack := scope.Stack
+	// Skip any pre-compile invocations, those are just fancy opcodes
+	if isPrecompiled(t.precompiles, common.Address(stack.Back(1).Bytes20())) {
+		return
+	}
+
+	size := stack.Back(inOff + 1)
+	if !size.IsUint64() || size.Uint64() < 4 {
+		return
+	}
+
+	input := memorySlice(scope.Memory, stack.Back(inOff), size)
+	if len(input) < 4 {
+		return
+	}
+	t.store(input[0:4], len(input)-4)
+}
+
+// CaptureFault implements the vm.Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *fourByteTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *fourByteTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+}
+
+// GetResult returns the json-encoded 4byte identifiers found and the number of
+// times they were called, and any error arising from the encoding or forceful
+// termination (via `Stop`).
+func (t *fourByteTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.ids)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *fourByteTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
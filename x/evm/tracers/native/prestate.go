@@ -0,0 +1,154 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func init() {
+	register("prestateTracer", newPrestateTracer)
+}
+
+// account is the state of an account prior to the transaction execution.
+type account struct {
+	Balance string                      `json:"balance"`
+	Nonce   uint64                      `json:"nonce"`
+	Code    string                      `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// prestateTracer is a native Go tracer that reports the state of the accounts and storage slots
+// touched by a transaction, prior to its execution. The output format matches the one from the
+// go-ethereum prestateTracer.
+// NOTE: unlike go-ethereum, the transaction fees are deducted and the sender nonce is incremented
+// by the AnteHandler, which isn't run when tracing. The sender balance is thus only adjusted by the
+// value transferred.
+type prestateTracer struct {
+	env       *vm.EVM
+	prestate  map[common.Address]*account
+	create    bool
+	to        common.Address
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newPrestateTracer returns a native go tracer which collects the state of the accounts touched
+// by a transaction.
+func newPrestateTracer(_ []common.Address) Tracer {
+	return &prestateTracer{prestate: make(map[common.Address]*account)}
+}
+
+// CaptureStart implements the vm.Tracer interface to initialize the tracing operation.
+func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.create = create
+	t.to = to
+
+	t.lookupAccount(from)
+	t.lookupAccount(to)
+
+	// The value has already been transferred from the sender to the recipient
+	// when the tracing starts.
+	if from != to {
+		toBal := new(big.Int).Sub(env.StateDB.GetBalance(to), value)
+		t.prestate[to].Balance = bigToHex(toBal)
+
+		fromBal := new(big.Int).Add(env.StateDB.GetBalance(from), value)
+		t.prestate[from].Balance = bigToHex(fromBal)
+	}
+
+	// The sender nonce is incremented within the EVM on contract creation.
+	if create && t.prestate[from].Nonce > 0 {
+		t.prestate[from].Nonce--
+	}
+}
+
+// CaptureState implements the vm.Tracer interface to trace a single step of VM execution.
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	stack := scope.Stack
+	caller := scope.Contract.Address()
+
+	switch op {
+	case vm.SLOAD, vm.SSTORE:
+		t.lookupStorage(caller, common.Hash(stack.Back(0).Bytes32()))
+	case vm.EXTCODECOPY, vm.EXTCODESIZE, vm.EXTCODEHASH, vm.BALANCE, vm.SELFDESTRUCT:
+		t.lookupAccount(common.Address(stack.Back(0).Bytes20()))
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.lookupAccount(common.Address(stack.Back(1).Bytes20()))
+	case vm.CREATE:
+		nonce := env.StateDB.GetNonce(caller)
+		t.lookupAccount(crypto.CreateAddress(caller, nonce))
+	case vm.CREATE2:
+		initCode := memorySlice(scope.Memory, stack.Back(1), stack.Back(2))
+		salt := stack.Back(3).Bytes32()
+		t.lookupAccount(crypto.CreateAddress2(caller, salt, crypto.Keccak256(initCode)))
+	}
+}
+
+// CaptureFault implements the vm.Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	// the created contract didn't exist prior to the transaction
+	if t.create {
+		delete(t.prestate, t.to)
+	}
+}
+
+// GetResult returns the json-encoded state of the touched accounts, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.prestate)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *prestateTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// lookupAccount fetches details of an account and adds it to the prestate
+// if it doesn't exist there.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.prestate[addr]; ok {
+		return
+	}
+
+	t.prestate[addr] = &account{
+		Balance: bigToHex(t.env.StateDB.GetBalance(addr)),
+		Nonce:   t.env.StateDB.GetNonce(addr),
+		Code:    bytesToHex(t.env.StateDB.GetCode(addr)),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+// lookupStorage fetches the requested storage slot and adds it to the prestate
+// of the given contract, if it isn't there yet.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+
+	if _, ok := t.prestate[addr].Storage[key]; ok {
+		return
+	}
+	t.prestate[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
+}
@@ -0,0 +1,99 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Tracer is a vm.Tracer that collects the tracing results in memory, so that they can be
+// returned as JSON once the execution is done. The go-ethereum JavaScript tracer satisfies
+// this interface as well.
+type Tracer interface {
+	vm.Tracer
+	// GetResult returns the JSON encoded result of the tracing or the error that
+	// interrupted it.
+	GetResult() (json.RawMessage, error)
+	// Stop terminates the tracing with the given error, eg: on timeout.
+	Stop(err error)
+}
+
+// ctors are the constructors of the native tracers, indexed by name. The names match the ones
+// from the go-ethereum built-in tracers, so that the native implementations are used instead of
+// the (much slower) JavaScript ones.
+var ctors = map[string]func(precompiles []common.Address) Tracer{}
+
+// register adds a native tracer constructor under the given name.
+func register(name string, ctor func(precompiles []common.Address) Tracer) {
+	ctors[name] = ctor
+}
+
+// New returns a new instance of the native tracer registered under the given name, given the
+// addresses of the precompiled contracts active on the traced block, including the stateful ones.
+// It returns false if there's no native tracer with that name.
+func New(name string, precompiles []common.Address) (Tracer, bool) {
+	ctor, ok := ctors[name]
+	if !ok {
+		return nil, false
+	}
+	return ctor(precompiles), true
+}
+
+// Names returns the names of the registered native tracers.
+func Names() []string {
+	names := make([]string, 0, len(ctors))
+	for name := range ctors {
+		names = append(names, name)
+	}
+	return names
+}
+
+// memorySlice returns a copy of the memory segment from offset with the given size. It returns
+// nil if the segment is out of the memory bounds.
+func memorySlice(memory *vm.Memory, offset, size *uint256.Int) []byte {
+	if !offset.IsUint64() || !size.IsUint64() {
+		return nil
+	}
+
+	off, n := offset.Uint64(), size.Uint64()
+	if n == 0 {
+		return []byte{}
+	}
+
+	end := off + n
+	if end < off || end > uint64(memory.Len()) {
+		return nil
+	}
+
+	return memory.GetCopy(int64(off), int64(n))
+}
+
+// isPrecompiled returns true if the given address is one of the precompiled contracts.
+func isPrecompiled(precompiles []common.Address, addr common.Address) bool {
+	for _, p := range precompiles {
+		if p == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func bytesToHex(s []byte) string {
+	return hexutil.Encode(s)
+}
+
+func bigToHex(n *big.Int) string {
+	if n == nil {
+		return "0x0"
+	}
+	return hexutil.EncodeBig(n)
+}
+
+func uintToHex(n uint64) string {
+	return hexutil.EncodeUint64(n)
+}
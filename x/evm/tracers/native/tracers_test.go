@@ -0,0 +1,224 @@
+package native_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/tests"
+
+	"github.com/tharsis/ethermint/x/evm/tracers/native"
+)
+
+// The testdata fixtures are the call tracer ones of go-ethereum v1.10.3, see:
+// https://github.com/ethereum/go-ethereum/tree/v1.10.3/eth/tracers/testdata
+
+// callFrame is a frame of a call trace, as decoded by the go-ethereum call tracer tests.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      common.Address  `json:"to"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output"`
+	Gas     *hexutil.Uint64 `json:"gas,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
+
+type callContext struct {
+	Number     math.HexOrDecimal64   `json:"number"`
+	Difficulty *math.HexOrDecimal256 `json:"difficulty"`
+	Time       math.HexOrDecimal64   `json:"timestamp"`
+	GasLimit   math.HexOrDecimal64   `json:"gasLimit"`
+	Miner      common.Address        `json:"miner"`
+}
+
+// tracerTest is a transaction to trace, along with the state and the block context to execute it
+// on, and the expected call trace.
+type tracerTest struct {
+	Genesis *core.Genesis   `json:"genesis"`
+	Context *callContext    `json:"context"`
+	Input   string          `json:"input"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// loadTests returns the test fixtures indexed by name.
+func loadTests(t *testing.T) map[string]*tracerTest {
+	files, err := ioutil.ReadDir("testdata")
+	require.NoError(t, err)
+
+	testCases := make(map[string]*tracerTest)
+	for _, file := range files {
+		blob, err := ioutil.ReadFile(filepath.Join("testdata", file.Name()))
+		require.NoError(t, err)
+
+		test := new(tracerTest)
+		require.NoError(t, json.Unmarshal(blob, test))
+		testCases[strings.TrimSuffix(strings.TrimPrefix(file.Name(), "call_tracer_"), ".json")] = test
+	}
+	require.NotEmpty(t, testCases)
+
+	return testCases
+}
+
+// run executes the transaction of the test on its prestate with the given tracer, and returns the
+// tracing result.
+func (test *tracerTest) run(t *testing.T, newTracer func(vm.TxContext, []common.Address) native.Tracer) json.RawMessage {
+	tx, origin := test.tx(t)
+	blockNumber := new(big.Int).SetUint64(uint64(test.Context.Number))
+	signer := ethtypes.MakeSigner(test.Genesis.Config, blockNumber)
+
+	txContext := vm.TxContext{
+		Origin:   origin,
+		GasPrice: tx.GasPrice(),
+	}
+	blockContext := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		Coinbase:    test.Context.Miner,
+		BlockNumber: blockNumber,
+		Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+		Difficulty:  (*big.Int)(test.Context.Difficulty),
+		GasLimit:    uint64(test.Context.GasLimit),
+	}
+	_, statedb := tests.MakePreState(rawdb.NewMemoryDatabase(), test.Genesis.Alloc, false)
+
+	precompiles := vm.ActivePrecompiles(test.Genesis.Config.Rules(blockNumber))
+	tracer := newTracer(txContext, precompiles)
+	evm := vm.NewEVM(blockContext, txContext, statedb, test.Genesis.Config, vm.Config{Debug: true, Tracer: tracer})
+
+	msg, err := tx.AsMessage(signer, nil)
+	require.NoError(t, err)
+
+	_, err = core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(tx.Gas())).TransitionDb()
+	require.NoError(t, err)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	return res
+}
+
+// tx returns the transaction of the test and its sender.
+func (test *tracerTest) tx(t *testing.T) (*ethtypes.Transaction, common.Address) {
+	tx := new(ethtypes.Transaction)
+	require.NoError(t, rlp.DecodeBytes(common.FromHex(test.Input), tx))
+
+	signer := ethtypes.MakeSigner(test.Genesis.Config, new(big.Int).SetUint64(uint64(test.Context.Number)))
+	origin, err := signer.Sender(tx)
+	require.NoError(t, err)
+
+	return tx, origin
+}
+
+// normalizeCallTrace returns the given call trace without the execution time, and with an empty
+// output on the call frames without one, as the call tracer fixtures were generated by earlier
+// go-ethereum versions.
+func normalizeCallTrace(t *testing.T, trace json.RawMessage) string {
+	var frame callFrame
+	require.NoError(t, json.Unmarshal(trace, &frame))
+	bz, err := json.Marshal(frame)
+	require.NoError(t, err)
+	return string(bz)
+}
+
+// nativeTracer returns a constructor of the native tracer with the given name.
+func nativeTracer(t *testing.T, name string) func(vm.TxContext, []common.Address) native.Tracer {
+	return func(_ vm.TxContext, precompiles []common.Address) native.Tracer {
+		tracer, ok := native.New(name, precompiles)
+		require.True(t, ok, name)
+		return tracer
+	}
+}
+
+// jsTracer returns a constructor of the go-ethereum JavaScript tracer with the given name.
+func jsTracer(t *testing.T, name string) func(vm.TxContext, []common.Address) native.Tracer {
+	return func(_ vm.TxContext, _ []common.Address) native.Tracer {
+		tracer, err := tracers.New(name, new(tracers.Context))
+		require.NoError(t, err, name)
+		return tracer
+	}
+}
+
+func TestCallTracer(t *testing.T) {
+	for name, test := range loadTests(t) {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res := test.run(t, nativeTracer(t, "callTracer"))
+			require.JSONEq(t, normalizeCallTrace(t, test.Result), normalizeCallTrace(t, res))
+		})
+	}
+}
+
+func TestTracersMatchJavaScript(t *testing.T) {
+	testCases := loadTests(t)
+
+	for _, name := range []string{"callTracer", "prestateTracer", "4byteTracer"} {
+		for testName, test := range testCases {
+			test := test
+			t.Run(name+"/"+testName, func(t *testing.T) {
+				expected := test.run(t, jsTracer(t, name))
+				res := test.run(t, nativeTracer(t, name))
+
+				switch name {
+				case "callTracer":
+					// the JavaScript call tracer reports its execution time
+					require.JSONEq(t, normalizeCallTrace(t, expected), normalizeCallTrace(t, res))
+				case "prestateTracer":
+					require.JSONEq(t, test.adjustPrestate(t, expected), string(res))
+				default:
+					require.JSONEq(t, string(expected), string(res))
+				}
+			})
+		}
+	}
+}
+
+// adjustPrestate returns the given prestate with the sender account as allocated on the genesis,
+// minus the gas bought and with the nonce incremented. The native prestate tracer doesn't revert
+// them, as they are done by the AnteHandler on Ethermint, which isn't run when tracing, while the
+// go-ethereum state transition does them before the tracing starts.
+// NOTE: the JavaScript prestate tracer adds back the gas used instead of the gas bought, so its
+// sender balance doesn't match the genesis when the gas isn't fully used.
+func (test *tracerTest) adjustPrestate(t *testing.T, prestate json.RawMessage) string {
+	var accounts map[common.Address]map[string]interface{}
+	require.NoError(t, json.Unmarshal(prestate, &accounts))
+
+	tx, origin := test.tx(t)
+	sender, found := test.Genesis.Alloc[origin]
+	require.True(t, found)
+	require.Contains(t, accounts, origin)
+
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasPrice())
+	accounts[origin]["balance"] = hexutil.EncodeBig(new(big.Int).Sub(sender.Balance, gasCost))
+
+	// the nonce is incremented by the EVM on contract creation
+	nonce := sender.Nonce
+	if tx.To() != nil {
+		nonce++
+	}
+	accounts[origin]["nonce"] = nonce
+
+	bz, err := json.Marshal(accounts)
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestNames(t *testing.T) {
+	require.ElementsMatch(t, []string{"callTracer", "prestateTracer", "4byteTracer"}, native.Names())
+}
@@ -60,7 +60,7 @@ func (args *CallArgs) ToMessage(globalGasCap uint64) ethtypes.Message {
 		accessList = *args.AccessList
 	}
 
-	msg := ethtypes.NewMessage(addr, args.To, 0, value, gas, gasPrice, data, accessList, false)
+	msg := ethtypes.NewMessage(addr, args.To, 0, value, gas, gasPrice, gasPrice, gasPrice, data, accessList, false)
 	return msg
 }
 
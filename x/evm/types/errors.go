@@ -30,6 +30,10 @@ const (
 	codeErrInconsistentGas
 	codeErrInvalidGasCap
 	codeErrInvalidBaseFee
+	codeErrTxRejected
+	codeErrDeployerNotAllowed
+	codeErrCallerBlocked
+	codeErrInvalidForkSchedule
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -85,6 +89,18 @@ var (
 
 	// ErrInvalidBaseFee returns an error if a the base fee cap value is invalid
 	ErrInvalidBaseFee = sdkerrors.Register(ModuleName, codeErrInvalidBaseFee, "invalid base fee")
+
+	// ErrTxRejected returns an error if a pre processing hook rejects the transaction
+	ErrTxRejected = sdkerrors.Register(ModuleName, codeErrTxRejected, "transaction rejected by pre processing hook")
+
+	// ErrDeployerNotAllowed returns an error if an address not allowed by the AllowedDeployers parameter deploys a contract.
+	ErrDeployerNotAllowed = sdkerrors.Register(ModuleName, codeErrDeployerNotAllowed, "contract deployment not allowed")
+
+	// ErrCallerBlocked returns an error if a caller blocked by the CallBlocklists parameter calls a contract.
+	ErrCallerBlocked = sdkerrors.Register(ModuleName, codeErrCallerBlocked, "contract call blocked")
+
+	// ErrInvalidForkSchedule returns an error if a hard fork or an extra EIP can't be scheduled.
+	ErrInvalidForkSchedule = sdkerrors.Register(ModuleName, codeErrInvalidForkSchedule, "invalid hard fork schedule")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
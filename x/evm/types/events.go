@@ -2,20 +2,28 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
+	EventTypeEthereumTx     = TypeMsgEthereumTx
+	EventTypeBlockBloom     = "block_bloom"
+	EventTypeTxLog          = "tx_log"
+	EventTypePrecompileLog  = "precompile_log"
+	EventTypeScheduleFork   = "schedule_fork"
+	EventTypeForkActivation = "fork_activation"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
 	AttributeKeyEthereumTxHash  = "ethereumTxHash"
+	AttributeKeyTxIndex         = "txIndex"
+	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyHeight           = "height"
+	AttributeKeyForks            = "forks"
+	AttributeKeyExtraEIPs        = "extra_eips"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
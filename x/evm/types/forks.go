@@ -0,0 +1,182 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Ethereum hard fork names of the chain config
+const (
+	ForkHomestead      = "homestead"
+	ForkDAO            = "dao_fork"
+	ForkEIP150         = "eip150"
+	ForkEIP155         = "eip155"
+	ForkEIP158         = "eip158"
+	ForkByzantium      = "byzantium"
+	ForkConstantinople = "constantinople"
+	ForkPetersburg     = "petersburg"
+	ForkIstanbul       = "istanbul"
+	ForkMuirGlacier    = "muir_glacier"
+	ForkBerlin         = "berlin"
+	ForkLondon         = "london"
+)
+
+// Forks defines the names of the hard forks of the chain config, in activation order
+var Forks = []string{
+	ForkHomestead, ForkDAO, ForkEIP150, ForkEIP155, ForkEIP158, ForkByzantium, ForkConstantinople,
+	ForkPetersburg, ForkIstanbul, ForkMuirGlacier, ForkBerlin, ForkLondon,
+}
+
+// optionalForks defines the hard forks that may be disabled while the next ones are enabled
+var optionalForks = map[string]bool{
+	ForkDAO:         true,
+	ForkMuirGlacier: true,
+}
+
+// forkBlock returns a pointer to the activation block field of the given hard fork
+func (cc *ChainConfig) forkBlock(fork string) (**sdk.Int, error) {
+	switch fork {
+	case ForkHomestead:
+		return &cc.HomesteadBlock, nil
+	case ForkDAO:
+		return &cc.DAOForkBlock, nil
+	case ForkEIP150:
+		return &cc.EIP150Block, nil
+	case ForkEIP155:
+		return &cc.EIP155Block, nil
+	case ForkEIP158:
+		return &cc.EIP158Block, nil
+	case ForkByzantium:
+		return &cc.ByzantiumBlock, nil
+	case ForkConstantinople:
+		return &cc.ConstantinopleBlock, nil
+	case ForkPetersburg:
+		return &cc.PetersburgBlock, nil
+	case ForkIstanbul:
+		return &cc.IstanbulBlock, nil
+	case ForkMuirGlacier:
+		return &cc.MuirGlacierBlock, nil
+	case ForkBerlin:
+		return &cc.BerlinBlock, nil
+	case ForkLondon:
+		return &cc.LondonBlock, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidChainConfig, "unknown hard fork %s, valid forks are: %v", fork, Forks)
+	}
+}
+
+// ForkBlock returns the activation block of the given hard fork, or nil if the fork is disabled.
+func (cc ChainConfig) ForkBlock(fork string) (*sdk.Int, error) {
+	block, err := cc.forkBlock(fork)
+	if err != nil {
+		return nil, err
+	}
+
+	return *block, nil
+}
+
+// ScheduleFork returns a copy of the chain config activating the given hard fork at the block
+// height. It returns an error if the hard forks are no longer activated in order.
+func (cc ChainConfig) ScheduleFork(fork string, height uint64) (ChainConfig, error) {
+	block, err := cc.forkBlock(fork)
+	if err != nil {
+		return cc, err
+	}
+
+	activation := sdk.NewIntFromUint64(height)
+	*block = &activation
+
+	if err := cc.ValidateForkOrder(); err != nil {
+		return cc, err
+	}
+
+	return cc, nil
+}
+
+// ValidateForkOrder returns an error if an enabled hard fork is activated before one of the
+// previous hard forks, or if one of the previous hard forks is disabled, unless it is optional.
+func (cc ChainConfig) ValidateForkOrder() error {
+	var (
+		lastFork  string
+		lastBlock *sdk.Int
+	)
+
+	for _, fork := range Forks {
+		block, _ := cc.ForkBlock(fork)
+
+		if lastFork != "" && block != nil {
+			if lastBlock == nil {
+				return sdkerrors.Wrapf(
+					ErrInvalidChainConfig, "unsupported fork ordering: %s not enabled, but %s enabled at %s",
+					lastFork, fork, block,
+				)
+			}
+			if lastBlock.GT(*block) {
+				return sdkerrors.Wrapf(
+					ErrInvalidChainConfig, "unsupported fork ordering: %s enabled at %s, but %s enabled at %s",
+					lastFork, lastBlock, fork, block,
+				)
+			}
+		}
+
+		// an optional fork that is disabled doesn't prevent the next ones from being enabled
+		if !optionalForks[fork] || block != nil {
+			lastFork, lastBlock = fork, block
+		}
+	}
+
+	return nil
+}
+
+// ForksActivatedAt returns the names of the hard forks of the chain config activated at the given
+// block height.
+func (cc ChainConfig) ForksActivatedAt(height uint64) []string {
+	activation := sdk.NewIntFromUint64(height)
+
+	forks := []string{}
+	for _, fork := range Forks {
+		block, _ := cc.ForkBlock(fork)
+		if block != nil && block.Equal(activation) {
+			forks = append(forks, fork)
+		}
+	}
+
+	return forks
+}
+
+// Validate performs a stateless validation of the activation. The hard forks are expected to be
+// scheduled in the chain config, so the activation can only define extra EIPs.
+func (fa ForkActivation) Validate() error {
+	if fa.Height == 0 {
+		return fmt.Errorf("activation height cannot be zero")
+	}
+
+	if len(fa.Forks) > 0 {
+		return fmt.Errorf("hard forks %v must be scheduled in the chain config", fa.Forks)
+	}
+
+	if len(fa.ExtraEIPs) == 0 {
+		return fmt.Errorf("no extra EIPs activated at height %d", fa.Height)
+	}
+
+	return ValidateScheduledEIPs(fa.ExtraEIPs)
+}
+
+// ValidateScheduledEIPs returns an error if one of the EIPs can't be activated or is duplicated.
+func ValidateScheduledEIPs(eips []int64) error {
+	if err := validateEIPs(eips); err != nil {
+		return err
+	}
+
+	seen := make(map[int64]bool)
+	for _, eip := range eips {
+		if seen[eip] {
+			return fmt.Errorf("duplicated EIP %d", eip)
+		}
+		seen[eip] = true
+	}
+
+	return nil
+}
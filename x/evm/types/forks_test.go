@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainConfigScheduleFork(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   func() ChainConfig
+		fork     string
+		height   uint64
+		expError bool
+	}{
+		{
+			"unknown fork",
+			DefaultChainConfig,
+			"shanghai", 10, true,
+		},
+		{
+			"next fork activated before",
+			DefaultChainConfig,
+			ForkBerlin, 10, true,
+		},
+		{
+			"previous fork disabled",
+			func() ChainConfig {
+				cc := DefaultChainConfig()
+				cc.BerlinBlock, cc.LondonBlock = nil, nil
+				return cc
+			},
+			ForkLondon, 10, true,
+		},
+		{
+			"optional fork disabled",
+			func() ChainConfig {
+				cc := DefaultChainConfig()
+				cc.MuirGlacierBlock, cc.BerlinBlock, cc.LondonBlock = nil, nil, nil
+				return cc
+			},
+			ForkBerlin, 10, false,
+		},
+		{
+			"last fork",
+			func() ChainConfig {
+				cc := DefaultChainConfig()
+				cc.LondonBlock = nil
+				return cc
+			},
+			ForkLondon, 10, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		cc := tc.config()
+		scheduled, err := cc.ScheduleFork(tc.fork, tc.height)
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.NoError(t, scheduled.Validate(), tc.name)
+		require.Equal(t, []string{tc.fork}, scheduled.ForksActivatedAt(tc.height), tc.name)
+
+		// the chain config is not modified
+		block, err := cc.ForkBlock(tc.fork)
+		require.NoError(t, err, tc.name)
+		require.Nil(t, block, tc.name)
+	}
+}
+
+func TestScheduleForkProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *ScheduleForkProposal
+		expError bool
+	}{
+		{"valid", &ScheduleForkProposal{Title: "title", Description: "desc", Fork: ForkLondon, Height: 10, ExtraEIPs: []int64{1344}}, false},
+		{"only extra EIPs", &ScheduleForkProposal{Title: "title", Description: "desc", Height: 10, ExtraEIPs: []int64{1344}}, false},
+		{"zero height", &ScheduleForkProposal{Title: "title", Description: "desc", Fork: ForkLondon}, true},
+		{"empty", &ScheduleForkProposal{Title: "title", Description: "desc", Height: 10}, true},
+		{"unknown fork", &ScheduleForkProposal{Title: "title", Description: "desc", Fork: "shanghai", Height: 10}, true},
+		{"invalid EIP", &ScheduleForkProposal{Title: "title", Description: "desc", Height: 10, ExtraEIPs: []int64{1}}, true},
+		{"duplicated EIP", &ScheduleForkProposal{Title: "title", Description: "desc", Height: 10, ExtraEIPs: []int64{1344, 1344}}, true},
+		{"empty title", &ScheduleForkProposal{Description: "desc", Fork: ForkLondon, Height: 10}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -18,8 +18,9 @@ func (ga GenesisAccount) Validate() error {
 // chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:      []GenesisAccount{},
+		Params:        DefaultParams(),
+		ScheduledEIPs: []ForkActivation{},
 	}
 }
 
@@ -37,5 +38,16 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenHeights := make(map[uint64]bool)
+	for _, activation := range gs.ScheduledEIPs {
+		if seenHeights[activation.Height] {
+			return fmt.Errorf("duplicated EIP activation height %d", activation.Height)
+		}
+		if err := activation.Validate(); err != nil {
+			return fmt.Errorf("invalid EIP activation at height %d: %w", activation.Height, err)
+		}
+		seenHeights[activation.Height] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,10 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// scheduled eips defines the extra EIPs scheduled by governance proposals
+	// that are not activated yet. The hard forks are scheduled in the chain
+	// config.
+	ScheduledEIPs []ForkActivation `protobuf:"bytes,4,rep,name=scheduled_eips,json=scheduledEips,proto3" json:"scheduled_eips"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +82,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledEIPs() []ForkActivation {
+	if m != nil {
+		return m.ScheduledEIPs
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +163,30 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbd, 0x4e, 0xeb, 0x30,
-	0x14, 0x8e, 0x6f, 0xab, 0xf6, 0xe2, 0x22, 0x40, 0x16, 0x12, 0x56, 0x07, 0xb7, 0xea, 0x94, 0xc9,
-	0x56, 0x8b, 0xc4, 0x8e, 0x17, 0x56, 0x94, 0x6e, 0x6c, 0x6e, 0x62, 0x25, 0x19, 0x12, 0x47, 0xb6,
-	0x1b, 0xc1, 0xca, 0xc8, 0xc4, 0x73, 0xf0, 0x24, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x5e, 0x04, 0xc5,
-	0x49, 0x8b, 0x20, 0xdb, 0xb1, 0xbf, 0xbf, 0x73, 0x3e, 0x48, 0xa4, 0x4d, 0xa4, 0xce, 0xd2, 0xdc,
-	0x32, 0x59, 0x66, 0xac, 0x5c, 0xb2, 0x58, 0xe6, 0xd2, 0xa4, 0x86, 0x16, 0x5a, 0x59, 0x85, 0x2e,
-	0x8e, 0x38, 0x95, 0x65, 0x46, 0xcb, 0xe5, 0xf4, 0x32, 0x56, 0xb1, 0x72, 0x20, 0x6b, 0xa6, 0x96,
-	0x37, 0x9d, 0xf6, 0x7c, 0x1a, 0xba, 0xc3, 0x16, 0x2f, 0x00, 0x9e, 0xde, 0xb5, 0xae, 0x6b, 0x2b,
-	0xac, 0x44, 0x1c, 0xfe, 0x17, 0x61, 0xa8, 0xb6, 0xb9, 0x35, 0x18, 0xcc, 0x07, 0xfe, 0x64, 0x35,
-	0xa7, 0x7f, 0x73, 0x68, 0xa7, 0xb8, 0x6d, 0x89, 0x7c, 0xb8, 0xfb, 0x98, 0x79, 0xc1, 0x51, 0x87,
-	0x6e, 0xe0, 0xa8, 0x10, 0x5a, 0x64, 0x06, 0x0f, 0xe6, 0xc0, 0x9f, 0xac, 0x70, 0xdf, 0xe1, 0xde,
-	0xe1, 0x9d, 0xb2, 0x63, 0x2f, 0x9e, 0x01, 0x3c, 0xfb, 0x6d, 0x8d, 0x30, 0x1c, 0x8b, 0x28, 0xd2,
-	0xd2, 0x34, 0xdb, 0x00, 0xff, 0x24, 0x38, 0x3c, 0x11, 0x82, 0xc3, 0x50, 0x45, 0x12, 0xff, 0x73,
-	0xdf, 0x6e, 0x46, 0x1c, 0x8e, 0x8d, 0x55, 0x5a, 0xc4, 0x12, 0x0f, 0xdc, 0xee, 0x57, 0xfd, 0x64,
-	0x77, 0x26, 0x3f, 0x6f, 0x82, 0xdf, 0x3e, 0x67, 0xe3, 0x75, 0xcb, 0x0f, 0x0e, 0x42, 0xce, 0x77,
-	0x15, 0x01, 0xfb, 0x8a, 0x80, 0xaf, 0x8a, 0x80, 0xd7, 0x9a, 0x78, 0xfb, 0x9a, 0x78, 0xef, 0x35,
-	0xf1, 0x1e, 0xfc, 0x38, 0xb5, 0xc9, 0x76, 0x43, 0x43, 0x95, 0x31, 0x9b, 0x08, 0x6d, 0x52, 0xc3,
-	0x7e, 0xaa, 0x7d, 0x74, 0xe5, 0xda, 0xa7, 0x42, 0x9a, 0xcd, 0xc8, 0x95, 0x7b, 0xfd, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x87, 0x96, 0x85, 0x5d, 0xc2, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0xee, 0x5c, 0x08, 0xdc, 0x3b, 0x5c, 0xb8, 0x37, 0x13, 0x8d, 0x0d, 0x8b, 0x42, 0x58, 0x75,
+	0xd5, 0x06, 0x4c, 0xdc, 0xd3, 0x04, 0x8d, 0x3b, 0x52, 0x76, 0x2e, 0x34, 0x43, 0x7b, 0xd2, 0x4e,
+	0xb4, 0x9d, 0x66, 0x66, 0x68, 0x74, 0xeb, 0x13, 0xf8, 0x1c, 0x3e, 0x09, 0x4b, 0x96, 0xae, 0xd0,
+	0x94, 0x9d, 0x4f, 0x61, 0x3a, 0x05, 0x8c, 0x12, 0x77, 0x67, 0xe6, 0xfb, 0x99, 0x6f, 0xce, 0x87,
+	0x2d, 0x50, 0x31, 0x88, 0x84, 0xa5, 0xca, 0x85, 0x3c, 0x71, 0xf3, 0xa1, 0x1b, 0x41, 0x0a, 0x92,
+	0x49, 0x27, 0x13, 0x5c, 0x71, 0xf2, 0x7f, 0x8f, 0x3b, 0x90, 0x27, 0x4e, 0x3e, 0xec, 0x1e, 0x45,
+	0x3c, 0xe2, 0x1a, 0x74, 0xcb, 0xa9, 0xe2, 0x75, 0xbb, 0x07, 0x3e, 0x25, 0x5d, 0x63, 0x83, 0x77,
+	0x84, 0xff, 0x5e, 0x54, 0xae, 0x33, 0x45, 0x15, 0x10, 0x0f, 0xff, 0xa6, 0x41, 0xc0, 0x17, 0xa9,
+	0x92, 0x26, 0xea, 0xd7, 0xec, 0xd6, 0xa8, 0xef, 0x7c, 0x7f, 0xc7, 0xd9, 0x2a, 0xc6, 0x15, 0xd1,
+	0xab, 0x2f, 0xd7, 0x3d, 0xc3, 0xdf, 0xeb, 0xc8, 0x19, 0x6e, 0x64, 0x54, 0xd0, 0x44, 0x9a, 0xb5,
+	0x3e, 0xb2, 0x5b, 0x23, 0xf3, 0xd0, 0x61, 0xaa, 0xf1, 0xad, 0x72, 0xcb, 0x26, 0xd7, 0xb8, 0x23,
+	0x83, 0x18, 0xc2, 0xc5, 0x1d, 0x84, 0x37, 0xc0, 0x32, 0x69, 0xd6, 0x7f, 0x4a, 0x70, 0xce, 0xc5,
+	0xed, 0x38, 0x50, 0x2c, 0xa7, 0x8a, 0xf1, 0xd4, 0x3b, 0x2e, 0x7d, 0x8a, 0x75, 0xaf, 0x3d, 0xdb,
+	0xe9, 0x27, 0x97, 0x53, 0xe9, 0xb7, 0xf7, 0x76, 0x13, 0x96, 0xc9, 0xc1, 0x23, 0xc2, 0x9d, 0xaf,
+	0xd1, 0x89, 0x89, 0x9b, 0x34, 0x0c, 0x05, 0xc8, 0xf2, 0xb7, 0xc8, 0xfe, 0xe3, 0xef, 0x8e, 0x84,
+	0xe0, 0x7a, 0xc0, 0x43, 0x30, 0x7f, 0xe9, 0x6b, 0x3d, 0x13, 0x0f, 0x37, 0xa5, 0xe2, 0x82, 0x46,
+	0x60, 0xd6, 0x74, 0xb2, 0x93, 0xc3, 0x64, 0x7a, 0x8d, 0xde, 0xbf, 0x32, 0xd0, 0xf3, 0x6b, 0xaf,
+	0x39, 0xab, 0xf8, 0xfe, 0x4e, 0xe8, 0x79, 0xcb, 0xc2, 0x42, 0xab, 0xc2, 0x42, 0x6f, 0x85, 0x85,
+	0x9e, 0x36, 0x96, 0xb1, 0xda, 0x58, 0xc6, 0xcb, 0xc6, 0x32, 0xae, 0xec, 0x88, 0xa9, 0x78, 0x31,
+	0x77, 0x02, 0x9e, 0xb8, 0x2a, 0xa6, 0x42, 0x32, 0xe9, 0x7e, 0x56, 0x77, 0xaf, 0xcb, 0x53, 0x0f,
+	0x19, 0xc8, 0x79, 0x43, 0x97, 0x77, 0xfa, 0x31, 0x00, 0xb9, 0xa1, 0x58, 0xd8, 0x22, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +209,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledEIPs) > 0 {
+		for iNdEx := len(m.ScheduledEIPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledEIPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +326,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledEIPs) > 0 {
+		for _, e := range m.ScheduledEIPs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +460,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEIPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledEIPs = append(m.ScheduledEIPs, ForkActivation{})
+			if err := m.ScheduledEIPs[len(m.ScheduledEIPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -189,6 +189,44 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid scheduled EIPs",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ScheduledEIPs: []ForkActivation{
+					{Height: 10, ExtraEIPs: []int64{1344}},
+					{Height: 20, ExtraEIPs: []int64{2200}},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated scheduled EIPs height",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ScheduledEIPs: []ForkActivation{
+					{Height: 10, ExtraEIPs: []int64{1344}},
+					{Height: 10, ExtraEIPs: []int64{2200}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid scheduled EIP",
+			genState: &GenesisState{
+				Params:        DefaultParams(),
+				ScheduledEIPs: []ForkActivation{{Height: 10, ExtraEIPs: []int64{1}}},
+			},
+			expPass: false,
+		},
+		{
+			name: "scheduled hard fork",
+			genState: &GenesisState{
+				Params:        DefaultParams(),
+				ScheduledEIPs: []ForkActivation{{Height: 10, Forks: []string{ForkBerlin}, ExtraEIPs: []int64{1344}}},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
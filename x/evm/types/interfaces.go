@@ -1,11 +1,16 @@
 package types
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
 )
 
 // AccountKeeper defines the expected account keeper interface
@@ -25,7 +30,7 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
-	// SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
@@ -37,11 +42,28 @@ type StakingKeeper interface {
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
 }
 
+// FeeMarketKeeper defines the expected interface needed to retrieve the EIP-1559 base fee.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+}
+
+// DistributionKeeper defines the expected interface needed to fund the community pool with the base fees.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
 // EvmHooks event hooks for evm tx processing
 type EvmHooks interface {
+	// Must be called before the message of the tx is applied, if return an error, the tx is rejected without being
+	// executed and the fees are not refunded.
+	PreTxProcessing(ctx sdk.Context, msg core.Message) error
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
 	PostTxProcessing(ctx sdk.Context, txHash common.Hash, logs []*ethtypes.Log) error
+	// Must be called after the message of the tx is applied, including failed and reverted executions, with the
+	// response of the execution. If return an error, the whole transaction is reverted.
+	PostTxExecution(ctx sdk.Context, msg core.Message, res *MsgEthereumTxResponse) error
 }
@@ -26,6 +26,7 @@ const (
 const (
 	prefixCode = iota + 1
 	prefixStorage
+	prefixScheduledEIPs
 )
 
 // prefix bytes for the EVM transient store
@@ -39,12 +40,20 @@ const (
 	prefixTransientTxHash
 	prefixTransientLogSize
 	prefixTransientTxLogs
+	prefixTransientFeePayer
+	prefixTransientGasUsed
+	prefixTransientBlockGasUsed
+	prefixTransientBlockTxsReverted
+	prefixTransientTxMsgs
+	prefixTransientTxMetrics
+	prefixTransientSimulation
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode    = []byte{prefixCode}
-	KeyPrefixStorage = []byte{prefixStorage}
+	KeyPrefixCode          = []byte{prefixCode}
+	KeyPrefixStorage       = []byte{prefixStorage}
+	KeyPrefixScheduledEIPs = []byte{prefixScheduledEIPs}
 )
 
 // Transient Store key prefixes
@@ -58,6 +67,13 @@ var (
 	KeyPrefixTransientTxHash            = []byte{prefixTransientTxHash}
 	KeyPrefixTransientLogSize           = []byte{prefixTransientLogSize}
 	KeyPrefixTransientTxLogs            = []byte{prefixTransientTxLogs}
+	KeyPrefixTransientFeePayer          = []byte{prefixTransientFeePayer}
+	KeyPrefixTransientGasUsed           = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBlockGasUsed      = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBlockTxsReverted  = []byte{prefixTransientBlockTxsReverted}
+	KeyPrefixTransientTxMsgs            = []byte{prefixTransientTxMsgs}
+	KeyPrefixTransientTxMetrics         = []byte{prefixTransientTxMetrics}
+	KeyPrefixTransientSimulation        = []byte{prefixTransientSimulation}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -236,9 +236,11 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
-// AsMessage creates an Ethereum core.Message from the msg fields
-func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer) (core.Message, error) {
-	return msg.AsTransaction().AsMessage(signer)
+// AsMessage creates an Ethereum core.Message from the msg fields. The gas price of the message is
+// the effective gas price of the transaction for the given base fee, or its gas fee cap if the base
+// fee is nil.
+func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+	return msg.AsTransaction().AsMessage(signer, baseFee)
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
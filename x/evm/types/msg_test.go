@@ -116,6 +116,32 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasicDynamicFeeTx() {
+	chainID := sdk.NewIntFromBigInt(suite.chainID)
+	fee := sdk.NewInt(100)
+	txData := &DynamicFeeTx{
+		ChainID:   &chainID,
+		GasLimit:  21000,
+		GasFeeCap: &fee,
+		GasTipCap: &fee,
+		To:        suite.to.Hex(),
+	}
+	suite.Require().NoError(txData.Validate())
+
+	dataAny, err := PackTxData(txData)
+	suite.Require().NoError(err)
+
+	msg := &MsgEthereumTx{Data: dataAny}
+	suite.Require().NoError(msg.ValidateBasic())
+
+	// the transaction converts to a go-ethereum dynamic fee transaction and back
+	tx := msg.AsTransaction()
+	suite.Require().Equal(uint8(types.DynamicFeeTxType), tx.Type())
+	suite.Require().Equal(fee.BigInt(), tx.GasFeeCap())
+	suite.Require().Equal(fee.BigInt(), tx.GasTipCap())
+	suite.Require().Equal(tx.Hash(), types.NewTx(NewTxDataFromTx(tx).AsEthereumData()).Hash())
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_Sign() {
 	testCases := []struct {
 		msg        string
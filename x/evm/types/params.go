@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/tharsis/ethermint/types"
 )
@@ -26,6 +27,14 @@ var (
 	ParamStoreKeyChainConfig  = []byte("ChainConfig")
 	ParamStoreKeyNoBaseFee    = []byte("NoBaseFee")
 
+	ParamStoreKeyActivePrecompiles = []byte("ActivePrecompiles")
+	ParamStoreKeyBankPrecompileGas = []byte("BankPrecompileGas")
+
+	ParamStoreKeyStakingPrecompileGas = []byte("StakingPrecompileGas")
+
+	ParamStoreKeyAllowedDeployers = []byte("AllowedDeployers")
+	ParamStoreKeyCallBlocklists   = []byte("CallBlocklists")
+
 	// AvailableExtraEIPs define the list of all EIPs that can be enabled by the EVM interpreter. These EIPs are applied in
 	// order and can override the instruction sets from the latest hard fork enabled by the ChainConfig. For more info
 	// check: https://github.com/ethereum/go-ethereum/blob/v1.10.4/core/vm/interpreter.go#L122
@@ -52,11 +61,34 @@ func NewParams(evmDenom string, enableCreate, enableCall bool, config ChainConfi
 // ExtraEIPs is empty to prevent overriding the latest hard fork instruction set
 func DefaultParams() Params {
 	return Params{
-		EvmDenom:     DefaultEVMDenom,
-		EnableCreate: true,
-		EnableCall:   true,
-		ChainConfig:  DefaultChainConfig(),
-		ExtraEIPs:    nil,
+		EvmDenom:             DefaultEVMDenom,
+		EnableCreate:         true,
+		EnableCall:           true,
+		ChainConfig:          DefaultChainConfig(),
+		ExtraEIPs:            nil,
+		BankPrecompileGas:    DefaultBankPrecompileGas(),
+		StakingPrecompileGas: DefaultStakingPrecompileGas(),
+	}
+}
+
+// DefaultBankPrecompileGas returns the default gas costs of the bank precompiled contract methods
+func DefaultBankPrecompileGas() BankPrecompileGas {
+	return BankPrecompileGas{
+		BalanceOf:   2000,
+		Transfer:    20000,
+		TotalSupply: 2000,
+	}
+}
+
+// DefaultStakingPrecompileGas returns the default gas costs of the staking precompiled contract methods
+func DefaultStakingPrecompileGas() StakingPrecompileGas {
+	return StakingPrecompileGas{
+		Delegate:        60000,
+		Undelegate:      60000,
+		Redelegate:      80000,
+		WithdrawRewards: 40000,
+		Delegation:      5000,
+		Rewards:         10000,
 	}
 }
 
@@ -74,6 +106,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableCall, &p.EnableCall, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyExtraEIPs, &p.ExtraEIPs, validateEIPs),
 		paramtypes.NewParamSetPair(ParamStoreKeyChainConfig, &p.ChainConfig, validateChainConfig),
+		paramtypes.NewParamSetPair(ParamStoreKeyActivePrecompiles, &p.ActivePrecompiles, validatePrecompiles),
+		paramtypes.NewParamSetPair(ParamStoreKeyBankPrecompileGas, &p.BankPrecompileGas, validateBankPrecompileGas),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedDeployers, &p.AllowedDeployers, validateAllowedDeployers),
+		paramtypes.NewParamSetPair(ParamStoreKeyCallBlocklists, &p.CallBlocklists, validateCallBlocklists),
+		paramtypes.NewParamSetPair(ParamStoreKeyStakingPrecompileGas, &p.StakingPrecompileGas, validateStakingPrecompileGas),
 	}
 }
 
@@ -87,6 +124,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	if err := validateAllowedDeployers(p.AllowedDeployers); err != nil {
+		return err
+	}
+
+	if err := validateCallBlocklists(p.CallBlocklists); err != nil {
+		return err
+	}
+
 	return p.ChainConfig.Validate()
 }
 
@@ -131,6 +180,135 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// IsPrecompileActive returns true if the stateful precompiled contract with the given address is
+// enabled.
+func (p Params) IsPrecompileActive(address common.Address) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) == address {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, precompile := range precompiles {
+		if err := types.ValidateAddress(precompile); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(precompile)
+		if seen[address] {
+			return fmt.Errorf("duplicate precompile address %s", precompile)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// IsDeployerAllowed returns true if the given address is allowed to deploy contracts.
+func (p Params) IsDeployerAllowed(address common.Address) bool {
+	if len(p.AllowedDeployers) == 0 {
+		return true
+	}
+
+	for _, deployer := range p.AllowedDeployers {
+		if common.HexToAddress(deployer) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBlockedCallers returns the hex addresses of the callers that are not allowed to call the given
+// contract.
+func (p Params) GetBlockedCallers(contract common.Address) []string {
+	for _, blocklist := range p.CallBlocklists {
+		if common.HexToAddress(blocklist.Contract) == contract {
+			return blocklist.BlockedCallers
+		}
+	}
+	return nil
+}
+
+// validateAddresses validates the given hex addresses, which must not contain duplicates.
+func validateAddresses(addresses []string) error {
+	seen := make(map[common.Address]bool)
+	for _, hex := range addresses {
+		if err := types.ValidateAddress(hex); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(hex)
+		if seen[address] {
+			return fmt.Errorf("duplicate address %s", hex)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+func validateAllowedDeployers(i interface{}) error {
+	deployers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid allowed deployer slice type: %T", i)
+	}
+
+	return validateAddresses(deployers)
+}
+
+func validateCallBlocklists(i interface{}) error {
+	blocklists, ok := i.([]CallBlocklist)
+	if !ok {
+		return fmt.Errorf("invalid call blocklist slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, blocklist := range blocklists {
+		if err := types.ValidateAddress(blocklist.Contract); err != nil {
+			return err
+		}
+
+		contract := common.HexToAddress(blocklist.Contract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate call blocklist for contract %s", blocklist.Contract)
+		}
+		seen[contract] = true
+
+		if err := validateAddresses(blocklist.BlockedCallers); err != nil {
+			return fmt.Errorf("invalid call blocklist for contract %s: %w", blocklist.Contract, err)
+		}
+	}
+
+	return nil
+}
+
+func validateBankPrecompileGas(i interface{}) error {
+	_, ok := i.(BankPrecompileGas)
+	if !ok {
+		return fmt.Errorf("invalid bank precompile gas type: %T", i)
+	}
+
+	return nil
+}
+
+func validateStakingPrecompileGas(i interface{}) error {
+	_, ok := i.(StakingPrecompileGas)
+	if !ok {
+		return fmt.Errorf("invalid staking precompile gas type: %T", i)
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
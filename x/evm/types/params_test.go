@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +39,70 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid precompile address",
+			Params{
+				EvmDenom:          "stake",
+				ActivePrecompiles: []string{"0x01"},
+			},
+			true,
+		},
+		{
+			"duplicate precompile address",
+			Params{
+				EvmDenom:          "stake",
+				ActivePrecompiles: []string{"0x0000000000000000000000000000000000000900", "0x0000000000000000000000000000000000000900"},
+			},
+			true,
+		},
+		{
+			"valid permissions",
+			Params{
+				EvmDenom:         "stake",
+				AllowedDeployers: []string{"0x0000000000000000000000000000000000000900"},
+				CallBlocklists: []CallBlocklist{
+					{Contract: "0x0000000000000000000000000000000000000900", BlockedCallers: []string{"0x0000000000000000000000000000000000000901"}},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate allowed deployer",
+			Params{
+				EvmDenom:         "stake",
+				AllowedDeployers: []string{"0x0000000000000000000000000000000000000900", "0x0000000000000000000000000000000000000900"},
+			},
+			true,
+		},
+		{
+			"invalid call blocklist contract",
+			Params{
+				EvmDenom:       "stake",
+				CallBlocklists: []CallBlocklist{{Contract: "0x01"}},
+			},
+			true,
+		},
+		{
+			"duplicate call blocklist contract",
+			Params{
+				EvmDenom: "stake",
+				CallBlocklists: []CallBlocklist{
+					{Contract: "0x0000000000000000000000000000000000000900"},
+					{Contract: "0x0000000000000000000000000000000000000900"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid blocked caller",
+			Params{
+				EvmDenom: "stake",
+				CallBlocklists: []CallBlocklist{
+					{Contract: "0x0000000000000000000000000000000000000900", BlockedCallers: []string{"0x01"}},
+				},
+			},
+			true,
+		},
 		{
 			"invalid chain config",
 			NewParams("ara", true, true, ChainConfig{}, 2929, 1884, 1344),
@@ -63,4 +128,30 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.Error(t, validatePrecompiles(""))
+	require.NoError(t, validatePrecompiles([]string{"0x0000000000000000000000000000000000000900"}))
+	require.Error(t, validateBankPrecompileGas(""))
+	require.NoError(t, validateBankPrecompileGas(DefaultBankPrecompileGas()))
+	require.Error(t, validateAllowedDeployers(""))
+	require.NoError(t, validateAllowedDeployers([]string{"0x0000000000000000000000000000000000000900"}))
+	require.Error(t, validateCallBlocklists(""))
+	require.NoError(t, validateCallBlocklists([]CallBlocklist{}))
+	require.Error(t, validateStakingPrecompileGas(""))
+	require.NoError(t, validateStakingPrecompileGas(DefaultStakingPrecompileGas()))
+}
+
+func TestParamsPermissions(t *testing.T) {
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000900")
+	caller := common.HexToAddress("0x0000000000000000000000000000000000000901")
+
+	params := DefaultParams()
+	require.True(t, params.IsDeployerAllowed(caller))
+	require.Empty(t, params.GetBlockedCallers(contract))
+
+	params.AllowedDeployers = []string{contract.Hex()}
+	params.CallBlocklists = []CallBlocklist{{Contract: contract.Hex(), BlockedCallers: []string{caller.Hex()}}}
+	require.True(t, params.IsDeployerAllowed(contract))
+	require.False(t, params.IsDeployerAllowed(caller))
+	require.Equal(t, []string{caller.Hex()}, params.GetBlockedCallers(contract))
+	require.Empty(t, params.GetBlockedCallers(caller))
 }
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StatefulPrecompiledContract defines a precompiled contract implemented in Go that has access to
+// the Cosmos SDK state, through the context and the keepers it's constructed with. Stateful
+// precompiles are registered on the EVM keeper and enabled through the ActivePrecompiles module
+// parameter.
+type StatefulPrecompiledContract interface {
+	// Address returns the address at which the precompiled contract is called.
+	Address() common.Address
+	// RequiredGas returns the gas required to execute the precompiled contract with the given input.
+	RequiredGas(ctx sdk.Context, input []byte) uint64
+	// Run executes the precompiled contract with the input, caller and value of the given contract.
+	// The state changes must be written to the given context, which is reverted along with the EVM
+	// state if the call fails. The readOnly flag is set for static calls, on which the state must
+	// not be modified. Returning vm.ErrExecutionReverted reverts the call with the returned data.
+	Run(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error)
+}
+
+// PrecompileHookPC is the program counter of the precompile stub code at which the stateful
+// precompiled contract is executed.
+const PrecompileHookPC = 6
+
+// PrecompileStubCode is the EVM bytecode of the stateful precompiled contracts. Once the stateful
+// precompiled contract has been executed at PrecompileHookPC, the stub code returns or reverts
+// with its output:
+//
+//	PUSH1 0x00 (output size)
+//	PUSH1 0x00 (output offset)
+//	PUSH1 0x00 (success)
+//	PUSH1 0x0a
+//	JUMPI
+//	REVERT
+//	JUMPDEST
+//	RETURN
+var PrecompileStubCode = []byte{
+	byte(vm.PUSH1), 0x00,
+	byte(vm.PUSH1), 0x00,
+	byte(vm.PUSH1), 0x00,
+	byte(vm.PUSH1), 0x0a,
+	byte(vm.JUMPI),
+	byte(vm.REVERT),
+	byte(vm.JUMPDEST),
+	byte(vm.RETURN),
+}
+
+// PrecompileStubCodeHash is the code hash of the stateful precompiled contracts.
+var PrecompileStubCodeHash = crypto.Keccak256Hash(PrecompileStubCode)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// constants
+const (
+	// ProposalTypeScheduleFork defines the type for a ScheduleForkProposal
+	ProposalTypeScheduleFork = "ScheduleFork"
+)
+
+// Implements Proposal Interface
+var _ govtypes.Content = &ScheduleForkProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeScheduleFork)
+	govtypes.RegisterProposalTypeCodec(&ScheduleForkProposal{}, "ethermint/ScheduleForkProposal")
+}
+
+// NewScheduleForkProposal returns a new ScheduleForkProposal
+func NewScheduleForkProposal(title, description, fork string, height uint64, extraEIPs []int64) govtypes.Content {
+	return &ScheduleForkProposal{
+		Title:       title,
+		Description: description,
+		Fork:        fork,
+		Height:      height,
+		ExtraEIPs:   extraEIPs,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*ScheduleForkProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*ScheduleForkProposal) ProposalType() string { return ProposalTypeScheduleFork }
+
+// ValidateBasic performs a stateless check of the proposal fields. The activation height is
+// checked against the current block height when the proposal is executed.
+func (sfp *ScheduleForkProposal) ValidateBasic() error {
+	if sfp.Height == 0 {
+		return fmt.Errorf("activation height cannot be zero")
+	}
+
+	if sfp.Fork == "" && len(sfp.ExtraEIPs) == 0 {
+		return fmt.Errorf("proposal must schedule a hard fork or extra EIPs")
+	}
+
+	if sfp.Fork != "" {
+		if _, err := (&ChainConfig{}).forkBlock(sfp.Fork); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateScheduledEIPs(sfp.ExtraEIPs); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(sfp)
+}
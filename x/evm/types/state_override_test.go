@@ -0,0 +1,42 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverrideValidate(t *testing.T) {
+	addr := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	balance := (*hexutil.Big)(big.NewInt(100))
+	negBalance := (*hexutil.Big)(big.NewInt(-1))
+
+	testCases := []struct {
+		name      string
+		overrides StateOverride
+		expPass   bool
+	}{
+		{"empty", StateOverride{}, true},
+		{"balance", StateOverride{addr: {Balance: &balance}}, true},
+		{"state", StateOverride{addr: {State: &map[common.Hash]common.Hash{}}}, true},
+		{"state diff", StateOverride{addr: {StateDiff: &map[common.Hash]common.Hash{}}}, true},
+		{"negative balance", StateOverride{addr: {Balance: &negBalance}}, false},
+		{
+			"state and state diff",
+			StateOverride{addr: {State: &map[common.Hash]common.Hash{}, StateDiff: &map[common.Hash]common.Hash{}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.overrides.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
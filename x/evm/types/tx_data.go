@@ -43,8 +43,8 @@ type TxData interface {
 func NewTxDataFromTx(tx *ethtypes.Transaction) TxData {
 	var txData TxData
 	switch tx.Type() {
-	// case ethtypes.DynamicFeeTxType:
-	// 	txData = newDynamicFeeTx(tx)
+	case ethtypes.DynamicFeeTxType:
+		txData = newDynamicFeeTx(tx)
 	case ethtypes.AccessListTxType:
 		txData = newAccessListTx(tx)
 	default:
@@ -71,6 +71,48 @@ func DeriveChainID(v *big.Int) *big.Int {
 	return v.Div(v, big.NewInt(2))
 }
 
+// EffectiveGasPrice returns the gas price paid per unit of gas by the transaction for the given
+// base fee, i.e min(gasFeeCap, baseFee + gasTipCap). For legacy and access list transactions, the
+// fee cap and the tip cap are both equal to the gas price. A nil base fee returns the fee cap.
+func EffectiveGasPrice(txData TxData, baseFee *big.Int) *big.Int {
+	feeCap := txData.GetGasFeeCap()
+	if feeCap == nil {
+		feeCap = new(big.Int)
+	}
+
+	if baseFee == nil {
+		return new(big.Int).Set(feeCap)
+	}
+
+	tipCap := txData.GetGasTipCap()
+	if tipCap == nil {
+		tipCap = new(big.Int)
+	}
+
+	price := new(big.Int).Add(baseFee, tipCap)
+	if price.Cmp(feeCap) > 0 {
+		return new(big.Int).Set(feeCap)
+	}
+	return price
+}
+
+// EffectiveGasTip returns the priority fee paid per unit of gas by the transaction for the given
+// base fee, i.e min(gasTipCap, gasFeeCap - baseFee). The returned value is negative if the fee cap
+// is lower than the base fee.
+func EffectiveGasTip(txData TxData, baseFee *big.Int) *big.Int {
+	price := EffectiveGasPrice(txData, baseFee)
+	if baseFee == nil {
+		return price
+	}
+	return price.Sub(price, baseFee)
+}
+
+// EffectiveFee returns the fee paid for the gas limit of the transaction at its effective gas price
+// for the given base fee. A nil base fee returns the fee of the transaction at its fee cap.
+func EffectiveFee(txData TxData, baseFee *big.Int) *big.Int {
+	return fee(EffectiveGasPrice(txData, baseFee), txData.GetGas())
+}
+
 func rawSignatureValues(vBz, rBz, sBz []byte) (v, r, s *big.Int) {
 	if len(vBz) > 0 {
 		v = new(big.Int).SetBytes(vBz)
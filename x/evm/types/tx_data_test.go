@@ -68,3 +68,44 @@ func TestTxData_DeriveChainID(t *testing.T) {
 		require.Equal(t, tc.expChainID, chainID, tc.msg)
 	}
 }
+
+func TestTxData_effectiveGasPrice(t *testing.T) {
+	gasPrice := sdk.NewInt(100)
+	feeCap := sdk.NewInt(100)
+	tipCap := sdk.NewInt(10)
+
+	testCases := []struct {
+		msg      string
+		data     TxData
+		baseFee  *big.Int
+		expPrice *big.Int
+		expTip   *big.Int
+	}{
+		{"legacy tx, nil base fee", &LegacyTx{GasPrice: &gasPrice}, nil, big.NewInt(100), big.NewInt(100)},
+		{"legacy tx, base fee", &LegacyTx{GasPrice: &gasPrice}, big.NewInt(40), big.NewInt(100), big.NewInt(60)},
+		{"access list tx, base fee", &AccessListTx{GasPrice: &gasPrice}, big.NewInt(40), big.NewInt(100), big.NewInt(60)},
+		{"dynamic fee tx, nil base fee", &DynamicFeeTx{GasFeeCap: &feeCap, GasTipCap: &tipCap}, nil, big.NewInt(100), big.NewInt(100)},
+		{"dynamic fee tx, tip cap bound", &DynamicFeeTx{GasFeeCap: &feeCap, GasTipCap: &tipCap}, big.NewInt(40), big.NewInt(50), big.NewInt(10)},
+		{"dynamic fee tx, fee cap bound", &DynamicFeeTx{GasFeeCap: &feeCap, GasTipCap: &tipCap}, big.NewInt(95), big.NewInt(100), big.NewInt(5)},
+		{"dynamic fee tx, fee cap below base fee", &DynamicFeeTx{GasFeeCap: &feeCap, GasTipCap: &tipCap}, big.NewInt(110), big.NewInt(100), big.NewInt(-10)},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPrice, EffectiveGasPrice(tc.data, tc.baseFee), tc.msg)
+		require.Equal(t, tc.expTip, EffectiveGasTip(tc.data, tc.baseFee), tc.msg)
+	}
+}
+
+func TestTxData_effectiveFee(t *testing.T) {
+	gasPrice := sdk.NewInt(100)
+	feeCap := sdk.NewInt(100)
+	tipCap := sdk.NewInt(10)
+
+	legacyTx := &LegacyTx{GasPrice: &gasPrice, GasLimit: 21000}
+	dynamicFeeTx := &DynamicFeeTx{GasFeeCap: &feeCap, GasTipCap: &tipCap, GasLimit: 21000}
+
+	require.Equal(t, legacyTx.Fee(), EffectiveFee(legacyTx, nil))
+	require.Equal(t, big.NewInt(2100000), EffectiveFee(legacyTx, big.NewInt(40)))
+	require.Equal(t, big.NewInt(2100000), EffectiveFee(dynamicFeeTx, nil))
+	require.Equal(t, big.NewInt(1050000), EffectiveFee(dynamicFeeTx, big.NewInt(40)))
+}
@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetBaseFeeHistoryCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -135,6 +136,59 @@ If the height is not provided, it will use the latest height from context.`,
 	return cmd
 }
 
+// GetBaseFeeHistoryCmd queries the base fee and the gas used of the blocks within a height range
+func GetBaseFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-history [from] [to]",
+		Short: "Get the base fee and the gas used of the blocks within a height range",
+		Long: `Get the base fee and the gas used of the blocks within a height range, as kept in the
+history of the recent blocks. The range includes both heights. If the last height is not provided,
+it will use the latest height from context.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+
+			var to int64
+			if len(args) == 2 {
+				to, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid to height: %w", err)
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFeeHistory(cmd.Context(), &types.QueryBaseFeeHistoryRequest{
+				From:       from,
+				To:         to,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "base fee history")
+	return cmd
+}
+
 func getContextHeight(ctx context.Context, height string) (context.Context, error) {
 	_, err := strconv.ParseInt(height, 10, 64)
 	if err != nil {
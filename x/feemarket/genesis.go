@@ -18,6 +18,10 @@ func InitGenesis(
 	k.SetBaseFee(ctx, data.BaseFee.BigInt())
 	k.SetBlockGasUsed(ctx, data.BlockGas)
 
+	for _, blockFees := range data.History {
+		k.SetBlockFees(ctx, blockFees)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -33,5 +37,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:   k.GetParams(ctx),
 		BaseFee:  baseFee,
 		BlockGas: k.GetBlockGasUsed(ctx),
+		History:  k.GetBlockFeesHistory(ctx),
 	}
 }
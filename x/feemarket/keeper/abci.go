@@ -12,6 +12,10 @@ import (
 // KVStore. The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
+	// keep the base fee and the gas used of the current block in the history, before the base fee
+	// of the next block is set
+	k.AppendBlockFees(ctx)
+
 	baseFee := k.CalculateBaseFee(ctx)
 	if baseFee == nil {
 		return
@@ -7,12 +7,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/tharsis/ethermint/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
-// block during EndBlock. If the NoBaseFee parameter is enabled, this function returns nil.
-// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
-// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+// block during EndBlock. If the NoBaseFee parameter is enabled, this function returns nil. The base
+// fee never falls below the MinGasPrice parameter.
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	params := k.GetParams(ctx)
 
@@ -20,6 +21,13 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
+	return math.BigMax(k.calculateBaseFee(ctx, params), params.MinGasPrice.BigInt())
+}
+
+// calculateBaseFee calculates the base fee for the current block from the gas used by the parent block.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
+// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+func (k Keeper) calculateBaseFee(ctx sdk.Context, params types.Params) *big.Int {
 	consParams := ctx.ConsensusParams()
 
 	// If the current block is the first EIP-1559 block, return the InitialBaseFee.
@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/tharsis/ethermint/x/feemarket/types"
 )
@@ -45,3 +51,83 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: int64(gas),
 	}, nil
 }
+
+// BaseFeeHistory implements the Query/BaseFeeHistory gRPC method
+func (k Keeper) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	to := req.To
+	if to == 0 {
+		to = ctx.BlockHeight()
+	}
+
+	if req.From < 0 || req.From > to {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.From, to)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the history is iterated over the height range only, starting from the page key if any
+	start := sdk.Uint64ToBigEndian(uint64(req.From))
+	if bytes.Compare(pageReq.Key, start) > 0 {
+		start = pageReq.Key
+	}
+	end := sdk.Uint64ToBigEndian(uint64(to) + 1)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeesHistory)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	history := []types.BlockFees{}
+	pageRes := &query.PageResponse{}
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		if uint64(len(history)) == limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = iterator.Key()
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+			continue
+		}
+
+		var blockFees types.BlockFees
+		if err := k.cdc.Unmarshal(iterator.Value(), &blockFees); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		history = append(history, blockFees)
+	}
+
+	if pageReq.CountTotal {
+		pageRes.Total = count
+	}
+
+	return &types.QueryBaseFeeHistoryResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Block Fees History
+// Bounded to the last HistoryRetention blocks, for the nodes that prune the
+// historical states.
+// ----------------------------------------------------------------------------
+
+// GetBlockFees returns the base fee and the gas used of the block at the given height from the
+// history, and false if the block is not kept in the history.
+func (k Keeper) GetBlockFees(ctx sdk.Context, height int64) (types.BlockFees, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeesHistory)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if len(bz) == 0 {
+		return types.BlockFees{}, false
+	}
+
+	var blockFees types.BlockFees
+	k.cdc.MustUnmarshal(bz, &blockFees)
+	return blockFees, true
+}
+
+// SetBlockFees sets the base fee and the gas used of a block to the history.
+func (k Keeper) SetBlockFees(ctx sdk.Context, blockFees types.BlockFees) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeesHistory)
+	bz := k.cdc.MustMarshal(&blockFees)
+	store.Set(sdk.Uint64ToBigEndian(uint64(blockFees.Height)), bz)
+}
+
+// GetBlockFeesHistory returns the base fee and the gas used of all the blocks kept in the history,
+// in ascending height order.
+func (k Keeper) GetBlockFeesHistory(ctx sdk.Context) []types.BlockFees {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeesHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	history := []types.BlockFees{}
+	for ; iterator.Valid(); iterator.Next() {
+		var blockFees types.BlockFees
+		k.cdc.MustUnmarshal(iterator.Value(), &blockFees)
+		history = append(history, blockFees)
+	}
+
+	return history
+}
+
+// AppendBlockFees adds the base fee and the gas used of the current block to the history, and
+// prunes the blocks that are older than the last HistoryRetention blocks. The base fee is zero if
+// it is not enabled.
+// CONTRACT: this should be only called during EndBlock, before updating the base fee.
+func (k Keeper) AppendBlockFees(ctx sdk.Context) {
+	retention := k.GetParams(ctx).HistoryRetention
+	k.pruneBlockFees(ctx, ctx.BlockHeight(), retention)
+	if retention == 0 {
+		return
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumedToLimit()
+	}
+
+	k.SetBlockFees(ctx, types.BlockFees{
+		Height:  ctx.BlockHeight(),
+		BaseFee: sdk.NewIntFromBigInt(baseFee),
+		GasUsed: gasUsed,
+	})
+}
+
+// pruneBlockFees deletes the blocks that don't belong to the last retention blocks up to the
+// given height from the history, i.e. all of them if the retention is zero. The blocks are pruned
+// in a single pass, so that reducing the HistoryRetention parameter takes effect immediately.
+func (k Keeper) pruneBlockFees(ctx sdk.Context, height int64, retention uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeesHistory)
+
+	// the blocks kept are the ones above the height - retention
+	if height <= 0 || uint64(height) < retention {
+		return
+	}
+	end := sdk.Uint64ToBigEndian(uint64(height) - retention + 1)
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
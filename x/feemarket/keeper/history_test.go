@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tharsis/ethermint/x/feemarket"
+	"github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// setHistoryRetention sets the HistoryRetention parameter.
+func (suite *KeeperTestSuite) setHistoryRetention(retention uint64) {
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.HistoryRetention = retention
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+}
+
+// appendBlocks appends the blocks of the given height range, included, to the history. The base
+// fee of each block is its height, and its gas used ten times its height.
+func (suite *KeeperTestSuite) appendBlocks(from, to int64) {
+	for height := from; height <= to; height++ {
+		gasMeter := sdk.NewGasMeter(1000000)
+		gasMeter.ConsumeGas(uint64(height)*10, "block")
+		ctx := suite.ctx.WithBlockHeight(height).WithBlockGasMeter(gasMeter)
+
+		suite.app.FeeMarketKeeper.SetBaseFee(ctx, big.NewInt(height))
+		suite.app.FeeMarketKeeper.AppendBlockFees(ctx)
+	}
+}
+
+// historyHeights returns the heights of the blocks kept in the history.
+func (suite *KeeperTestSuite) historyHeights() []int64 {
+	heights := []int64{}
+	for _, blockFees := range suite.app.FeeMarketKeeper.GetBlockFeesHistory(suite.ctx) {
+		heights = append(heights, blockFees.Height)
+	}
+	return heights
+}
+
+func (suite *KeeperTestSuite) TestAppendBlockFees() {
+	suite.setHistoryRetention(3)
+	suite.appendBlocks(1, 5)
+	suite.Require().Equal([]int64{3, 4, 5}, suite.historyHeights())
+
+	blockFees, found := suite.app.FeeMarketKeeper.GetBlockFees(suite.ctx, 4)
+	suite.Require().True(found)
+	suite.Require().Equal(types.BlockFees{Height: 4, BaseFee: sdk.NewInt(4), GasUsed: 40}, blockFees)
+
+	_, found = suite.app.FeeMarketKeeper.GetBlockFees(suite.ctx, 2)
+	suite.Require().False(found)
+
+	// shrinking the retention prunes the older blocks at once
+	suite.setHistoryRetention(1)
+	suite.appendBlocks(6, 6)
+	suite.Require().Equal([]int64{6}, suite.historyHeights())
+
+	// a zero retention disables the history and prunes it
+	suite.setHistoryRetention(0)
+	suite.appendBlocks(7, 7)
+	suite.Require().Empty(suite.historyHeights())
+
+	// growing the retention back only keeps the new blocks
+	suite.setHistoryRetention(3)
+	suite.appendBlocks(8, 9)
+	suite.Require().Equal([]int64{8, 9}, suite.historyHeights())
+}
+
+func (suite *KeeperTestSuite) TestAppendBlockFeesBaseFeeNotEnabled() {
+	suite.setHistoryRetention(3)
+	ctx := suite.ctx.WithBlockHeight(2)
+	suite.app.FeeMarketKeeper.AppendBlockFees(ctx)
+
+	blockFees, found := suite.app.FeeMarketKeeper.GetBlockFees(ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.ZeroInt(), blockFees.BaseFee)
+}
+
+func (suite *KeeperTestSuite) TestQueryBaseFeeHistory() {
+	suite.setHistoryRetention(10)
+	suite.appendBlocks(1, 5)
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+
+	testCases := []struct {
+		msg        string
+		req        *types.QueryBaseFeeHistoryRequest
+		expHeights []int64
+		expNext    bool
+		expTotal   uint64
+		expPass    bool
+	}{
+		{"empty request", nil, nil, false, 0, false},
+		{"negative from", &types.QueryBaseFeeHistoryRequest{From: -1, To: 3}, nil, false, 0, false},
+		{"from after to", &types.QueryBaseFeeHistoryRequest{From: 4, To: 3}, nil, false, 0, false},
+		{
+			"offset and key",
+			&types.QueryBaseFeeHistoryRequest{Pagination: &query.PageRequest{Offset: 1, Key: sdk.Uint64ToBigEndian(2)}},
+			nil, false, 0, false,
+		},
+		{"range", &types.QueryBaseFeeHistoryRequest{From: 2, To: 4}, []int64{2, 3, 4}, false, 0, true},
+		{"to the latest height", &types.QueryBaseFeeHistoryRequest{From: 4}, []int64{4, 5}, false, 0, true},
+		{"range out of the history", &types.QueryBaseFeeHistoryRequest{From: 6, To: 8}, []int64{}, false, 0, true},
+		{
+			"first page",
+			&types.QueryBaseFeeHistoryRequest{From: 1, Pagination: &query.PageRequest{Limit: 2}},
+			[]int64{1, 2}, true, 0, true,
+		},
+		{
+			"offset with count total",
+			&types.QueryBaseFeeHistoryRequest{From: 1, Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}},
+			[]int64{2, 3}, true, 5, true,
+		},
+		{
+			"last page with count total",
+			&types.QueryBaseFeeHistoryRequest{From: 2, Pagination: &query.PageRequest{Offset: 2, Limit: 2, CountTotal: true}},
+			[]int64{4, 5}, false, 4, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := suite.app.FeeMarketKeeper.BaseFeeHistory(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			heights := []int64{}
+			for _, blockFees := range res.History {
+				heights = append(heights, blockFees.Height)
+			}
+			suite.Require().Equal(tc.expHeights, heights)
+			suite.Require().Equal(tc.expNext, res.Pagination.NextKey != nil)
+			suite.Require().Equal(tc.expTotal, res.Pagination.Total)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryBaseFeeHistoryNextKey() {
+	suite.setHistoryRetention(10)
+	suite.appendBlocks(1, 5)
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+
+	req := &types.QueryBaseFeeHistoryRequest{From: 2, Pagination: &query.PageRequest{Limit: 2}}
+	heights := []int64{}
+	for {
+		res, err := suite.app.FeeMarketKeeper.BaseFeeHistory(sdk.WrapSDKContext(suite.ctx), req)
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.History), 2)
+
+		for _, blockFees := range res.History {
+			heights = append(heights, blockFees.Height)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+
+	suite.Require().Equal([]int64{2, 3, 4, 5}, heights)
+}
+
+func (suite *KeeperTestSuite) TestExportImportHistory() {
+	suite.setHistoryRetention(3)
+	suite.appendBlocks(1, 5)
+
+	genState := feemarket.ExportGenesis(suite.ctx, suite.app.FeeMarketKeeper)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Len(genState.History, 3)
+
+	suite.SetupTest()
+	feemarket.InitGenesis(suite.ctx, suite.app.FeeMarketKeeper, *genState)
+	suite.Require().Equal([]int64{3, 4, 5}, suite.historyHeights())
+	suite.Require().Equal(genState.History, suite.app.FeeMarketKeeper.GetBlockFeesHistory(suite.ctx))
+
+	// the history must be in ascending height order
+	genState.History[0], genState.History[1] = genState.History[1], genState.History[0]
+	suite.Require().Error(genState.Validate())
+}
@@ -0,0 +1,23 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// MigrateStore migrates the fee market params from version 1 to 2, setting the MinGasPrice,
+// BaseFeeDestination and HistoryRetention params added in version 2 to their default values, i.e.
+// no minimum gas price, burned base fees and the default history retention.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	paramSpace.Set(ctx, types.ParamStoreKeyMinGasPrice, defaultParams.MinGasPrice)
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseFeeDestination, defaultParams.BaseFeeDestination)
+	paramSpace.Set(ctx, types.ParamStoreKeyHistoryRetention, defaultParams.HistoryRetention)
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	return params.Validate()
+}
@@ -0,0 +1,41 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2 "github.com/tharsis/ethermint/x/feemarket/migrations/v2"
+	"github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// params of version 1
+	paramSpace.Set(ctx, types.ParamStoreKeyNoBaseFee, false)
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseFeeChangeDenominator, uint32(8))
+	paramSpace.Set(ctx, types.ParamStoreKeyElasticityMultiplier, uint32(2))
+	paramSpace.Set(ctx, types.ParamStoreKeyInitialBaseFee, int64(1000))
+	paramSpace.Set(ctx, types.ParamStoreKeyEnableHeight, int64(10))
+
+	var params types.Params
+	require.Panics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+
+	require.NoError(t, v2.MigrateStore(ctx, paramSpace))
+
+	paramSpace.GetParamSet(ctx, &params)
+	require.Equal(t, types.NewParams(false, 8, 2, 1000, 10, sdk.ZeroInt(), types.BASE_FEE_DESTINATION_BURN, types.DefaultHistoryRetention), params)
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -22,6 +24,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeDestination enumerates the destinations of the base fees paid by the
+// Ethereum transactions.
+type BaseFeeDestination int32
+
+const (
+	// BASE_FEE_DESTINATION_BURN burns the base fees, as on Ethereum.
+	BASE_FEE_DESTINATION_BURN BaseFeeDestination = 0
+	// BASE_FEE_DESTINATION_FEE_COLLECTOR keeps the base fees in the fee
+	// collector, which distributes them to the validators along with the
+	// priority fees.
+	BASE_FEE_DESTINATION_FEE_COLLECTOR BaseFeeDestination = 1
+	// BASE_FEE_DESTINATION_COMMUNITY_POOL sends the base fees to the community
+	// pool.
+	BASE_FEE_DESTINATION_COMMUNITY_POOL BaseFeeDestination = 2
+)
+
+var BaseFeeDestination_name = map[int32]string{
+	0: "BASE_FEE_DESTINATION_BURN",
+	1: "BASE_FEE_DESTINATION_FEE_COLLECTOR",
+	2: "BASE_FEE_DESTINATION_COMMUNITY_POOL",
+}
+
+var BaseFeeDestination_value = map[string]int32{
+	"BASE_FEE_DESTINATION_BURN":           0,
+	"BASE_FEE_DESTINATION_FEE_COLLECTOR":  1,
+	"BASE_FEE_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x BaseFeeDestination) String() string {
+	return proto.EnumName(BaseFeeDestination_name, int32(x))
+}
+
+func (BaseFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no base fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -34,6 +72,14 @@ type Params struct {
 	InitialBaseFee int64 `protobuf:"varint,4,opt,name=initial_base_fee,json=initialBaseFee,proto3" json:"initial_base_fee,omitempty"`
 	// height at which the base fee calculation is enabled.
 	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
+	// minimum gas price defines the floor the base fee can't fall below.
+	MinGasPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_gas_price"`
+	// base fee destination defines where the base fees paid by the Ethereum
+	// transactions go.
+	BaseFeeDestination BaseFeeDestination `protobuf:"varint,7,opt,name=base_fee_destination,json=baseFeeDestination,proto3,enum=ethermint.feemarket.v1.BaseFeeDestination" json:"base_fee_destination,omitempty"`
+	// history retention defines the number of recent blocks whose base fee and
+	// gas used are kept in the store. Zero disables the history.
+	HistoryRetention uint64 `protobuf:"varint,8,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -104,8 +150,82 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeDestination() BaseFeeDestination {
+	if m != nil {
+		return m.BaseFeeDestination
+	}
+	return BASE_FEE_DESTINATION_BURN
+}
+
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+// BlockFees defines the base fee and the gas used of a block, as kept in the
+// history.
+type BlockFees struct {
+	// height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base fee of the block, zero if the base fee is not enabled.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
+	// gas used by the block.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *BlockFees) Reset()         { *m = BlockFees{} }
+func (m *BlockFees) String() string { return proto.CompactTextString(m) }
+func (*BlockFees) ProtoMessage()    {}
+func (*BlockFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *BlockFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFees.Merge(m, src)
+}
+func (m *BlockFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFees proto.InternalMessageInfo
+
+func (m *BlockFees) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFees) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeDestination", BaseFeeDestination_name, BaseFeeDestination_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*BlockFees)(nil), "ethermint.feemarket.v1.BlockFees")
 }
 
 func init() {
@@ -113,25 +233,42 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4a, 0x03, 0x31,
-	0x10, 0x86, 0x1b, 0xab, 0x45, 0xa3, 0x15, 0x59, 0x54, 0x16, 0x84, 0x50, 0x14, 0x64, 0x4f, 0xbb,
-	0x94, 0x9e, 0xbd, 0x54, 0x91, 0x5e, 0x04, 0xe9, 0xd1, 0x4b, 0xc8, 0xd6, 0x69, 0x33, 0xb8, 0x49,
-	0x4a, 0x32, 0x2d, 0xf6, 0x2d, 0x7c, 0x2c, 0x8f, 0x3d, 0x7a, 0x94, 0xf6, 0xe8, 0x4b, 0x08, 0x5b,
-	0xdb, 0x78, 0x9c, 0xff, 0xfb, 0x06, 0x7e, 0x7e, 0x7e, 0x0b, 0xa4, 0xc1, 0x1b, 0xb4, 0x54, 0x8c,
-	0x01, 0x8c, 0xf2, 0x6f, 0x40, 0xc5, 0xbc, 0x1b, 0x8f, 0x7c, 0xea, 0x1d, 0xb9, 0xe4, 0x72, 0xe7,
-	0xe5, 0x11, 0xcd, 0xbb, 0xd7, 0x3f, 0x8c, 0xb7, 0x9e, 0x95, 0x57, 0x26, 0x24, 0x82, 0x1f, 0x5b,
-	0x27, 0x4b, 0x15, 0x40, 0x8e, 0x01, 0x52, 0xd6, 0x61, 0xd9, 0xe1, 0xf0, 0xc8, 0xba, 0xbe, 0x0a,
-	0xf0, 0x08, 0x90, 0xdc, 0xf1, 0xab, 0x2d, 0x94, 0x23, 0xad, 0xec, 0x04, 0xe4, 0x2b, 0x58, 0x67,
-	0xd0, 0x2a, 0x72, 0x3e, 0xdd, 0xeb, 0xb0, 0xac, 0x3d, 0x4c, 0xcb, 0x8d, 0x7d, 0x5f, 0x0b, 0x0f,
-	0x91, 0x27, 0x3d, 0x7e, 0x01, 0x95, 0x0a, 0x84, 0x23, 0xa4, 0x85, 0x34, 0xb3, 0x8a, 0x70, 0x5a,
-	0x21, 0xf8, 0xb4, 0x59, 0x3f, 0x9e, 0x47, 0xf8, 0xb4, 0x63, 0x49, 0xc6, 0xcf, 0xd0, 0x22, 0xa1,
-	0xaa, 0x62, 0xb1, 0xfd, 0x0e, 0xcb, 0x9a, 0xc3, 0xd3, 0xbf, 0x7c, 0xdb, 0xee, 0x86, 0xb7, 0xc1,
-	0xaa, 0xb2, 0x02, 0xa9, 0x01, 0x27, 0x9a, 0xd2, 0x83, 0x5a, 0x3b, 0xd9, 0x84, 0x83, 0x3a, 0xeb,
-	0x0f, 0x3e, 0x57, 0x82, 0x2d, 0x57, 0x82, 0x7d, 0xaf, 0x04, 0xfb, 0x58, 0x8b, 0xc6, 0x72, 0x2d,
-	0x1a, 0x5f, 0x6b, 0xd1, 0x78, 0xc9, 0x27, 0x48, 0x7a, 0x56, 0xe6, 0x23, 0x67, 0x0a, 0xd2, 0xca,
-	0x07, 0x0c, 0x45, 0x9c, 0xf6, 0xfd, 0xdf, 0xb8, 0xb4, 0x98, 0x42, 0x28, 0x5b, 0xf5, 0xac, 0xbd,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0x3a, 0xd4, 0xaa, 0x80, 0x01, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x18, 0xcc, 0x42, 0xfe, 0x10, 0x96, 0x1f, 0x94, 0xae, 0x28, 0x32, 0x54, 0x35, 0x16, 0x48, 0xd4,
+	0xa2, 0xaa, 0x2d, 0xca, 0xb9, 0x07, 0x1c, 0x42, 0x89, 0x04, 0x31, 0x32, 0xe1, 0xd0, 0xaa, 0xd2,
+	0x6a, 0xed, 0x7c, 0xd8, 0x2b, 0xec, 0xdd, 0xc8, 0xbb, 0xa0, 0xf2, 0x06, 0x6d, 0x4f, 0x7d, 0x87,
+	0xbe, 0x0c, 0xea, 0x89, 0x63, 0xd5, 0x03, 0xaa, 0xe0, 0x45, 0xaa, 0x18, 0x13, 0x23, 0x91, 0x53,
+	0x4f, 0xf6, 0x37, 0x33, 0xbb, 0x9a, 0x99, 0xd5, 0x87, 0x37, 0x40, 0x27, 0x90, 0x67, 0x5c, 0x68,
+	0xf7, 0x14, 0x20, 0x63, 0xf9, 0x19, 0x68, 0xf7, 0x62, 0xab, 0x1a, 0x9c, 0x61, 0x2e, 0xb5, 0x24,
+	0x4b, 0x63, 0x9d, 0x53, 0x51, 0x17, 0x5b, 0x2b, 0x8b, 0xb1, 0x8c, 0x65, 0x21, 0x71, 0x47, 0x7f,
+	0xf7, 0xea, 0xb5, 0x9f, 0xd3, 0xb8, 0x71, 0xc4, 0x72, 0x96, 0x29, 0x62, 0xe2, 0x39, 0x21, 0x69,
+	0xc8, 0x14, 0xd0, 0x53, 0x00, 0x03, 0x59, 0xc8, 0x6e, 0x06, 0xb3, 0x42, 0x7a, 0x4c, 0xc1, 0x1e,
+	0x00, 0x79, 0x87, 0x5f, 0x3c, 0x90, 0x34, 0x4a, 0x98, 0x88, 0x81, 0x0e, 0x40, 0xc8, 0x8c, 0x0b,
+	0xa6, 0x65, 0x6e, 0x4c, 0x59, 0xc8, 0x9e, 0x0f, 0x8c, 0xf0, 0x5e, 0xdd, 0x2e, 0x04, 0xbb, 0x15,
+	0x4f, 0xb6, 0xf1, 0x73, 0x48, 0x99, 0xd2, 0x3c, 0xe2, 0xfa, 0x92, 0x66, 0xe7, 0xa9, 0xe6, 0xc3,
+	0x94, 0x43, 0x6e, 0x4c, 0x17, 0x07, 0x17, 0x2b, 0xf2, 0x70, 0xcc, 0x11, 0x1b, 0xb7, 0xb8, 0xe0,
+	0x9a, 0xb3, 0xb4, 0x32, 0x56, 0xb7, 0x90, 0x3d, 0x1d, 0x2c, 0x94, 0xf8, 0x83, 0xbb, 0x75, 0x3c,
+	0x0f, 0x82, 0x85, 0x29, 0xd0, 0x04, 0x78, 0x9c, 0x68, 0xe3, 0xbf, 0x42, 0xf6, 0xff, 0x3d, 0xb8,
+	0x5f, 0x60, 0x24, 0xc0, 0xf3, 0x19, 0x17, 0x34, 0x66, 0x8a, 0x0e, 0x73, 0x1e, 0x81, 0xd1, 0xb0,
+	0x90, 0x3d, 0xeb, 0x39, 0x57, 0x37, 0xab, 0xb5, 0xdf, 0x37, 0xab, 0x1b, 0x31, 0xd7, 0xc9, 0x79,
+	0xe8, 0x44, 0x32, 0x73, 0x23, 0xa9, 0x32, 0xa9, 0xca, 0xcf, 0x1b, 0x35, 0x38, 0x73, 0xf5, 0xe5,
+	0x10, 0x94, 0xd3, 0x15, 0x3a, 0x98, 0xcb, 0xb8, 0x78, 0xcf, 0xd4, 0xd1, 0xe8, 0x0a, 0xf2, 0x09,
+	0x2f, 0x8e, 0x6b, 0x19, 0x80, 0xd2, 0xa3, 0xb8, 0x5c, 0x0a, 0x63, 0xc6, 0x42, 0xf6, 0xc2, 0xdb,
+	0x4d, 0x67, 0xf2, 0x73, 0x38, 0xa5, 0xef, 0xdd, 0xea, 0x44, 0x40, 0xc2, 0x27, 0x18, 0x79, 0x8d,
+	0x9f, 0x25, 0x5c, 0x69, 0x99, 0x5f, 0xd2, 0x1c, 0x34, 0x88, 0xe2, 0xea, 0xa6, 0x85, 0xec, 0x7a,
+	0xd0, 0x2a, 0x89, 0xe0, 0x01, 0x5f, 0xfb, 0x8a, 0xf0, 0xac, 0x97, 0xca, 0xe8, 0x6c, 0x0f, 0x40,
+	0x91, 0x25, 0xdc, 0x28, 0xab, 0x40, 0x45, 0x15, 0xe5, 0x44, 0xba, 0xb8, 0x39, 0xee, 0x72, 0xea,
+	0x9f, 0xf2, 0xcf, 0x94, 0x46, 0xc9, 0x32, 0x6e, 0x8e, 0xba, 0x3c, 0x57, 0x30, 0x28, 0x9e, 0xb1,
+	0x1e, 0xcc, 0xc4, 0x4c, 0x9d, 0x28, 0x18, 0x6c, 0x7e, 0x43, 0x98, 0x3c, 0xcd, 0x48, 0x5e, 0xe2,
+	0x65, 0x6f, 0xe7, 0xb8, 0x43, 0xf7, 0x3a, 0x1d, 0xba, 0xdb, 0x39, 0xee, 0x77, 0x7b, 0x3b, 0xfd,
+	0xae, 0xdf, 0xa3, 0xde, 0x49, 0xd0, 0x6b, 0xd5, 0xc8, 0x06, 0x5e, 0x9b, 0x48, 0x8f, 0xe6, 0xb6,
+	0x7f, 0x70, 0xd0, 0x69, 0xf7, 0xfd, 0xa0, 0x85, 0xc8, 0x2b, 0xbc, 0x3e, 0x51, 0xd7, 0xf6, 0x0f,
+	0x0f, 0x4f, 0x7a, 0xdd, 0xfe, 0x07, 0x7a, 0xe4, 0xfb, 0x07, 0xad, 0xa9, 0x95, 0xfa, 0x97, 0x1f,
+	0x66, 0xcd, 0xdb, 0xbf, 0xba, 0x35, 0xd1, 0xf5, 0xad, 0x89, 0xfe, 0xdc, 0x9a, 0xe8, 0xfb, 0x9d,
+	0x59, 0xbb, 0xbe, 0x33, 0x6b, 0xbf, 0xee, 0xcc, 0xda, 0x47, 0xe7, 0x51, 0x64, 0x9d, 0xb0, 0x5c,
+	0x71, 0xe5, 0x56, 0x8b, 0xf6, 0xf9, 0xd1, 0xaa, 0x15, 0xf1, 0xc3, 0x46, 0xb1, 0x36, 0xdb, 0x7f,
+	0x07, 0x00, 0xae, 0x12, 0x0f, 0x92, 0x8e, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -154,6 +291,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryRetention != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BaseFeeDestination != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeDestination))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.EnableHeight != 0 {
 		i = encodeVarintFeemarket(dAtA, i, uint64(m.EnableHeight))
 		i--
@@ -187,6 +344,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -219,6 +419,31 @@ func (m *Params) Size() (n int) {
 	if m.EnableHeight != 0 {
 		n += 1 + sovFeemarket(uint64(m.EnableHeight))
 	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeDestination != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeDestination))
+	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovFeemarket(uint64(m.HistoryRetention))
+	}
+	return n
+}
+
+func (m *BlockFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -353,6 +578,200 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDestination", wireType)
+			}
+			m.BaseFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeDestination |= BaseFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -12,6 +12,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:   DefaultParams(),
 		BaseFee:  sdk.ZeroInt(),
 		BlockGas: 0,
+		History:  []BlockFees{},
 	}
 }
 
@@ -22,5 +23,28 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("base fee cannot be negative: %s", gs.BaseFee)
 	}
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// a zero base fee is not set
+	if gs.BaseFee.IsPositive() && gs.BaseFee.LT(gs.Params.MinGasPrice) {
+		return fmt.Errorf("base fee %s cannot be lower than the min gas price %s", gs.BaseFee, gs.Params.MinGasPrice)
+	}
+
+	for i, blockFees := range gs.History {
+		if blockFees.Height <= 0 {
+			return fmt.Errorf("block fees height must be positive: %d", blockFees.Height)
+		}
+
+		if i > 0 && blockFees.Height <= gs.History[i-1].Height {
+			return fmt.Errorf("block fees history must be in ascending height order: %d after %d", blockFees.Height, gs.History[i-1].Height)
+		}
+
+		if blockFees.BaseFee.IsNil() || blockFees.BaseFee.IsNegative() {
+			return fmt.Errorf("block fees base fee cannot be nil or negative at height %d", blockFees.Height)
+		}
+	}
+
+	return nil
 }
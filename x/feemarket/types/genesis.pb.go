@@ -34,6 +34,9 @@ type GenesisState struct {
 	// block gas is the amount of gas used on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// history defines the base fee and the gas used of the blocks kept in the
+	// history, in ascending height order.
+	History []BlockFees `protobuf:"bytes,4,rep,name=history,proto3" json:"history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +86,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetHistory() []BlockFees {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -92,25 +102,27 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0x5a, 0x6a, 0x1b, 0x9d, 0x82, 0x48, 0xa9, 0x70, 0x0d, 0x22, 0x25, 0x8b, 0x77,
-	0x54, 0x57, 0xa7, 0x0c, 0xd6, 0x6e, 0x12, 0x37, 0x97, 0x72, 0x89, 0xaf, 0x49, 0x88, 0xc9, 0x85,
-	0x7b, 0x67, 0xd1, 0x6f, 0xe1, 0x87, 0xf1, 0x43, 0x74, 0xec, 0x28, 0x0e, 0x45, 0x92, 0x2f, 0x22,
-	0xb9, 0x96, 0xb6, 0x83, 0x4e, 0x77, 0xbc, 0xf7, 0xfb, 0xbf, 0x1f, 0xfc, 0xed, 0x4b, 0xd0, 0x09,
-	0xa8, 0x3c, 0x2d, 0x34, 0x9f, 0x01, 0xe4, 0x42, 0x65, 0xa0, 0xf9, 0x7c, 0xc4, 0x63, 0x28, 0x00,
-	0x53, 0x64, 0xa5, 0x92, 0x5a, 0x3a, 0x67, 0x5b, 0x8a, 0x6d, 0x29, 0x36, 0x1f, 0xf5, 0x4f, 0x63,
-	0x19, 0x4b, 0x83, 0xf0, 0xe6, 0xb7, 0xa6, 0xfb, 0xc3, 0x7f, 0x6e, 0xee, 0xa2, 0x86, 0xbb, 0xf8,
-	0x24, 0xf6, 0xc9, 0x78, 0xed, 0x79, 0xd4, 0x42, 0x83, 0x73, 0x6b, 0xb7, 0x4b, 0xa1, 0x44, 0x8e,
-	0x3d, 0xe2, 0x12, 0xef, 0xf8, 0x9a, 0xb2, 0xbf, 0xbd, 0xec, 0xc1, 0x50, 0x7e, 0x6b, 0xb1, 0x1a,
-	0x58, 0xc1, 0x26, 0xe3, 0x4c, 0xec, 0x4e, 0x28, 0x10, 0xa6, 0x33, 0x80, 0xde, 0x81, 0x4b, 0xbc,
-	0xae, 0xcf, 0x9a, 0xfd, 0xf7, 0x6a, 0x30, 0x8c, 0x53, 0x9d, 0xbc, 0x86, 0x2c, 0x92, 0x39, 0x8f,
-	0x24, 0xe6, 0x12, 0x37, 0xcf, 0x15, 0x3e, 0x67, 0x5c, 0xbf, 0x97, 0x80, 0x6c, 0x52, 0xe8, 0xe0,
-	0xa8, 0xc9, 0xdf, 0x01, 0x38, 0xe7, 0x76, 0x37, 0x7c, 0x91, 0x51, 0x36, 0x8d, 0x05, 0xf6, 0x0e,
-	0x5d, 0xe2, 0xb5, 0x82, 0x8e, 0x19, 0x8c, 0x05, 0xfa, 0xf7, 0x8b, 0x8a, 0x92, 0x65, 0x45, 0xc9,
-	0x4f, 0x45, 0xc9, 0x47, 0x4d, 0xad, 0x65, 0x4d, 0xad, 0xaf, 0x9a, 0x5a, 0x4f, 0x6c, 0xcf, 0xa3,
-	0x13, 0xa1, 0x30, 0x45, 0xbe, 0xeb, 0xe2, 0x6d, 0xaf, 0x0d, 0xe3, 0x0c, 0xdb, 0xa6, 0x87, 0x9b,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x41, 0xbf, 0x7e, 0x16, 0x85, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x6e, 0xf2, 0x30,
+	0x14, 0x86, 0xe3, 0x0f, 0xc4, 0x4f, 0xf8, 0xa6, 0xa8, 0xaa, 0x22, 0x2a, 0x99, 0xb4, 0xaa, 0x50,
+	0x96, 0xda, 0x82, 0xae, 0x5d, 0x9a, 0x01, 0xca, 0x56, 0xd1, 0xad, 0x0b, 0x72, 0xe8, 0x21, 0x89,
+	0x68, 0x30, 0xf2, 0x71, 0x51, 0xb9, 0x8b, 0x5e, 0x16, 0x23, 0x63, 0xd5, 0x01, 0x55, 0xe4, 0x22,
+	0xba, 0x56, 0x31, 0xbf, 0x43, 0x99, 0x6c, 0xd9, 0xcf, 0x73, 0xce, 0xab, 0xd7, 0xbe, 0x06, 0x1d,
+	0x83, 0x4a, 0x93, 0x89, 0xe6, 0x23, 0x80, 0x54, 0xa8, 0x31, 0x68, 0x3e, 0x6b, 0xf1, 0x08, 0x26,
+	0x80, 0x09, 0xb2, 0xa9, 0x92, 0x5a, 0x3a, 0xe7, 0x7b, 0x8a, 0xed, 0x29, 0x36, 0x6b, 0xd5, 0xcf,
+	0x22, 0x19, 0x49, 0x83, 0xf0, 0xfc, 0xb6, 0xa1, 0xeb, 0xcd, 0x13, 0x33, 0x0f, 0xaa, 0xe1, 0xae,
+	0x7e, 0x88, 0xfd, 0xbf, 0xbb, 0xd9, 0xf3, 0xa4, 0x85, 0x06, 0xe7, 0xce, 0x2e, 0x4d, 0x85, 0x12,
+	0x29, 0xba, 0xc4, 0x23, 0x7e, 0xad, 0x4d, 0xd9, 0xdf, 0x7b, 0xd9, 0xa3, 0xa1, 0x82, 0xe2, 0x62,
+	0xd5, 0xb0, 0xfa, 0x5b, 0xc7, 0xe9, 0xd9, 0x95, 0x50, 0x20, 0x0c, 0x46, 0x00, 0xee, 0x3f, 0x8f,
+	0xf8, 0xd5, 0x80, 0xe5, 0xff, 0x5f, 0xab, 0x46, 0x33, 0x4a, 0x74, 0xfc, 0x16, 0xb2, 0xa1, 0x4c,
+	0xf9, 0x50, 0x62, 0x2a, 0x71, 0x7b, 0xdc, 0xe0, 0xcb, 0x98, 0xeb, 0xf9, 0x14, 0x90, 0xf5, 0x26,
+	0xba, 0x5f, 0xce, 0xfd, 0x0e, 0x80, 0x73, 0x61, 0x57, 0xc3, 0x57, 0x39, 0x1c, 0x0f, 0x22, 0x81,
+	0x6e, 0xc1, 0x23, 0x7e, 0xb1, 0x5f, 0x31, 0x0f, 0x5d, 0x81, 0xce, 0xbd, 0x5d, 0x8e, 0x13, 0xd4,
+	0x52, 0xcd, 0xdd, 0xa2, 0x57, 0xf0, 0x6b, 0xed, 0xcb, 0x53, 0x31, 0x83, 0x5c, 0xe9, 0x00, 0xec,
+	0x92, 0xee, 0xbc, 0xe0, 0x61, 0xb1, 0xa6, 0x64, 0xb9, 0xa6, 0xe4, 0x7b, 0x4d, 0xc9, 0x47, 0x46,
+	0xad, 0x65, 0x46, 0xad, 0xcf, 0x8c, 0x5a, 0xcf, 0xec, 0x28, 0xaa, 0x8e, 0x85, 0xc2, 0x04, 0xf9,
+	0xa1, 0xce, 0xf7, 0xa3, 0x42, 0x4d, 0xec, 0xb0, 0x64, 0xaa, 0xbc, 0xfd, 0x1d, 0x00, 0x35, 0x8c,
+	0x89, 0x9d, 0xc8, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +145,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -185,6 +211,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -309,6 +341,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, BlockFees{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
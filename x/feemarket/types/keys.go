@@ -16,10 +16,12 @@ const (
 const (
 	prefixBlockGasUsed = iota + 1
 	prefixBaseFee
+	prefixBlockFeesHistory
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasUsed = []byte{prefixBlockGasUsed}
-	KeyPrefixBaseFee      = []byte{prefixBaseFee}
+	KeyPrefixBlockGasUsed     = []byte{prefixBlockGasUsed}
+	KeyPrefixBaseFee          = []byte{prefixBaseFee}
+	KeyPrefixBlockFeesHistory = []byte{prefixBlockFeesHistory}
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,6 +12,7 @@ const (
 	DefaultBaseFeeChangeDenominator = 8
 	DefaultElasticityMultiplier     = 2
 	DefaultInitialBaseFee           = 1000000000
+	DefaultHistoryRetention         = 1024
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -22,6 +24,9 @@ var (
 	ParamStoreKeyElasticityMultiplier     = []byte("ElasticityMultiplier")
 	ParamStoreKeyInitialBaseFee           = []byte("InitialBaseFee")
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
+	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
+	ParamStoreKeyBaseFeeDestination       = []byte("BaseFeeDestination")
+	ParamStoreKeyHistoryRetention         = []byte("HistoryRetention")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -30,13 +35,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(noBaseFee bool, baseFeeChangeDenom, elasticityMultiplier uint32, initialBaseFee, enableHeight int64) Params {
+func NewParams(
+	noBaseFee bool, baseFeeChangeDenom, elasticityMultiplier uint32, initialBaseFee, enableHeight int64,
+	minGasPrice sdk.Int, baseFeeDestination BaseFeeDestination, historyRetention uint64,
+) Params {
 	return Params{
 		NoBaseFee:                noBaseFee,
 		BaseFeeChangeDenominator: baseFeeChangeDenom,
 		ElasticityMultiplier:     elasticityMultiplier,
 		InitialBaseFee:           initialBaseFee,
 		EnableHeight:             enableHeight,
+		MinGasPrice:              minGasPrice,
+		BaseFeeDestination:       baseFeeDestination,
+		HistoryRetention:         historyRetention,
 	}
 }
 
@@ -49,6 +60,9 @@ func DefaultParams() Params {
 		ElasticityMultiplier:     DefaultElasticityMultiplier,
 		InitialBaseFee:           DefaultInitialBaseFee,
 		EnableHeight:             math.MaxInt64,
+		MinGasPrice:              sdk.ZeroInt(),
+		BaseFeeDestination:       BASE_FEE_DESTINATION_BURN,
+		HistoryRetention:         DefaultHistoryRetention,
 	}
 }
 
@@ -60,6 +74,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
 		paramtypes.NewParamSetPair(ParamStoreKeyInitialBaseFee, &p.InitialBaseFee, validateInitialBaseFee),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFeeDestination, &p.BaseFeeDestination, validateBaseFeeDestination),
+		paramtypes.NewParamSetPair(ParamStoreKeyHistoryRetention, &p.HistoryRetention, validateHistoryRetention),
 	}
 }
 
@@ -77,7 +94,11 @@ func (p Params) Validate() error {
 		return fmt.Errorf("enable height cannot be negative: %d", p.EnableHeight)
 	}
 
-	return nil
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	return validateBaseFeeDestination(p.BaseFeeDestination)
 }
 
 func validateBool(i interface{}) error {
@@ -134,3 +155,41 @@ func validateEnableHeight(i interface{}) error {
 
 	return nil
 }
+
+func validateMinGasPrice(i interface{}) error {
+	value, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if value.IsNil() {
+		return fmt.Errorf("min gas price cannot be nil")
+	}
+
+	if value.IsNegative() {
+		return fmt.Errorf("min gas price cannot be negative: %s", value)
+	}
+
+	return nil
+}
+
+func validateBaseFeeDestination(i interface{}) error {
+	value, ok := i.(BaseFeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := BaseFeeDestination_name[int32(value)]; !ok {
+		return fmt.Errorf("invalid base fee destination: %d", value)
+	}
+
+	return nil
+}
+
+func validateHistoryRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}